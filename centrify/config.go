@@ -1,10 +1,16 @@
 package centrify
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/dmc"
+	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/oauth"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/webcookie"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 // Config - Centrify Platform client struct
@@ -16,9 +22,48 @@ type Config struct {
 	Password       string
 	Token          string
 	UseDMC         bool
+	AuthType       string // oauth, oauth_token, dmc or unpw. Derived from UseDMC and Token if it is empty
 	LogLevel       string
 	LogPath        string
 	SkipCertVerify bool
+
+	// MFA settings for unpw authentication
+	MFATOTPSecret string            // Base32 secret OATH codes are generated from
+	MFAAnswers    map[string]string // Answers keyed by mechanism name or security question
+	MFAMechanisms []string          // Mechanism names in order of preference
+
+	// Retry settings for transient API failures
+	RetryMaxAttempts int
+	RetryMinBackoff  int // seconds
+	RetryMaxBackoff  int // seconds
+
+	// Client side throttling shared by all resources of this provider
+	RateLimit   float64 // requests per second
+	RateBurst   int
+	MaxInFlight int
+}
+
+// Authentication types supported by the provider
+const (
+	authTypeOAuth      = "oauth"
+	authTypeOAuthToken = "oauth_token"
+	authTypeDMC        = "dmc"
+	authTypeUnPw       = "unpw"
+)
+
+// authType returns authentication type. If auth_type isn't set, it is derived from use_dmc and token
+// as in previous versions of the provider.
+func (c *Config) authType() string {
+	if c.AuthType != "" {
+		return c.AuthType
+	}
+	if c.UseDMC {
+		return authTypeDMC
+	}
+	if c.Token != "" {
+		return authTypeOAuthToken
+	}
+	return authTypeOAuth
 }
 
 // Valid - Validate provider configuration
@@ -26,27 +71,46 @@ func (c *Config) Valid() error {
 	if c.URL == "" {
 		return fmt.Errorf(" Tenant URL must be provided for the Centrify provider")
 	}
-	if c.Scope == "" {
-		return fmt.Errorf(" Scope must be provided for the Centrify provider")
+
+	if c.RetryMaxBackoff < c.RetryMinBackoff {
+		return fmt.Errorf(" retry_max_backoff must not be less than retry_min_backoff")
 	}
 
-	if !c.UseDMC && c.Token == "" {
-		// If DMC isn't used and token isn't supplied, make sure appid user username is provided
+	switch c.authType() {
+	case authTypeOAuth:
 		if c.AppID == "" {
 			return fmt.Errorf(" AppID must be provided for the Centrify provider")
 		}
-		if c.Username == "" {
-			return fmt.Errorf(" Username or token must be provided for the Centrify provider")
+		if c.Scope == "" {
+			return fmt.Errorf(" Scope must be provided for the Centrify provider")
+		}
+		if c.Username == "" || c.Password == "" {
+			return fmt.Errorf(" Username and password of OAuth client must be provided for the Centrify provider")
+		}
+	case authTypeOAuthToken:
+		if c.Token == "" {
+			return fmt.Errorf(" Token must be provided for the Centrify provider")
+		}
+	case authTypeDMC:
+		if c.Scope == "" {
+			return fmt.Errorf(" Scope must be provided for the Centrify provider")
+		}
+	case authTypeUnPw:
+		if c.Username == "" || c.Password == "" {
+			return fmt.Errorf(" Username and password must be provided for the Centrify provider")
 		}
+	default:
+		return fmt.Errorf(" Invalid auth_type %s", c.AuthType)
 	}
 
 	return nil
 }
 
-func (c *Config) getClient() (interface{}, error) {
-	var client interface{}
+func (c *Config) getClient(log *logger.Logger) (*restapi.RestClient, error) {
+	var client *restapi.RestClient
 	var err error
-	if c.UseDMC {
+	switch c.authType() {
+	case authTypeDMC:
 		// use DMC to return authenticated Rest client
 		call := dmc.DMC{}
 		call.Service = c.URL
@@ -55,18 +119,103 @@ func (c *Config) getClient() (interface{}, error) {
 		call.SkipCertVerify = c.SkipCertVerify
 
 		client, err = call.GetClient()
-	} else {
+	case authTypeUnPw:
+		// use username and password, answering MFA challenges without user interaction
+		call := webcookie.WebCookie{}
+		call.Service = c.URL
+		call.ClientID = c.Username
+		call.ClientSecret = c.Password
+		call.SkipCertVerify = c.SkipCertVerify
+		call.Responder = c.mfaResponder()
+		call.MechanismPreference = c.MFAMechanisms
+		if len(call.MechanismPreference) == 0 {
+			call.MechanismPreference = webcookie.MechanismPreferenceFromEnv()
+		}
+
+		client, err = call.GetClient()
+	default:
 		// use OAuth authentication
 		call := oauth.OauthClient{
 			Service:        c.URL,
 			AppID:          c.AppID,
 			Scope:          c.Scope,
-			Token:          c.Token,
 			ClientID:       c.Username,
 			ClientSecret:   c.Password,
 			SkipCertVerify: c.SkipCertVerify,
 		}
+		if c.authType() == authTypeOAuthToken {
+			call.Token = c.Token
+		}
 		client, err = call.GetClient()
 	}
-	return client, err
+	if err != nil {
+		return nil, err
+	}
+
+	client.Logger = log
+	client.RetryPolicy = &restapi.RetryPolicy{
+		MaxAttempts: c.RetryMaxAttempts,
+		MinBackoff:  time.Duration(c.RetryMinBackoff) * time.Second,
+		MaxBackoff:  time.Duration(c.RetryMaxBackoff) * time.Second,
+	}
+	if c.RateLimit > 0 || c.MaxInFlight > 0 {
+		client.Throttle = restapi.NewThrottle(c.RateLimit, c.RateBurst, c.MaxInFlight)
+	}
+	return client, nil
+}
+
+// mfaResponder returns responder answering MFA challenges from provider configuration first, then
+// from CENTRIFY_MFA_* environment variables. PF and EMAIL mechanisms are polled until approved.
+// Terraform has no terminal to prompt so anything else fails authentication.
+func (c *Config) mfaResponder() webcookie.ChainResponder {
+	responder := webcookie.ChainResponder{}
+	if len(c.MFAAnswers) > 0 {
+		responder = append(responder, webcookie.AnswerResponder(c.MFAAnswers))
+	}
+	if c.MFATOTPSecret != "" {
+		responder = append(responder, &webcookie.TOTPResponder{Secret: c.MFATOTPSecret})
+	}
+	return append(responder, webcookie.ResponderFromEnv(c.Password)...)
+}
+
+// providerMeta holds everything owned by one configured provider so that aliased providers
+// talking to different tenants don't share REST client, logger or settings
+type providerMeta struct {
+	client *restapi.RestClient
+	logger *logger.Logger
+	config Config
+}
+
+// newLogger returns logger configured with log_level and logpath of this provider
+func (c *Config) newLogger() *logger.Logger {
+	l := logger.NewLogger()
+	switch c.LogLevel {
+	case "fatal":
+		l.SetLevel(logger.LevelFatal)
+	case "error":
+		l.SetLevel(logger.LevelError)
+	case "info":
+		l.SetLevel(logger.LevelInfo)
+	case "debug":
+		l.SetLevel(logger.LevelDebug)
+	}
+	if c.LogPath != "" {
+		l.SetLogPath(c.LogPath)
+		l.EnableErrorStackTrace()
+	}
+	return l
+}
+
+// getRestClient returns the provider REST client bound to a context that is cancelled
+// when Terraform stops the provider or when the operation timeout identified by timeoutKey expires.
+// The returned cancel function must be called once the operation completes.
+func getRestClient(d *schema.ResourceData, m interface{}, timeoutKey string) (*restapi.RestClient, context.CancelFunc) {
+	client := m.(*providerMeta).client
+	ctx, cancel := context.WithTimeout(client.Context(), d.Timeout(timeoutKey))
+	return client.WithContext(ctx), cancel
+}
+
+// getLogger returns logger of the provider
+func getLogger(m interface{}) *logger.Logger {
+	return m.(*providerMeta).logger
 }
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -71,8 +69,9 @@ func getDSAuthenticationProfileSchema() map[string]*schema.Schema {
 }
 
 func dataSourceAuthenticationProfileRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding authentication profile")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding authentication profile")
 	object := vault.NewAuthenticationProfile(client)
 	object.Name = d.Get("name").(string)
 
@@ -86,7 +85,6 @@ func dataSourceAuthenticationProfileRead(d *schema.ResourceData, m interface{})
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "additional_data":
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -78,8 +76,9 @@ func getDSCloudProviderSchema() map[string]*schema.Schema {
 }
 
 func dataSourceCloudProviderRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding CloudProvider")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding CloudProvider")
 	object := vault.NewCloudProvider(client)
 	object.CloudAccountID = d.Get("cloud_account_id").(string)
 	object.Name = d.Get("name").(string)
@@ -94,7 +93,6 @@ func dataSourceCloudProviderRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -103,8 +101,9 @@ func getDSConnectorSchema() map[string]*schema.Schema {
 }
 
 func dataSourceConnectorRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding connector")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding connector")
 	object := vault.NewConnector(client)
 	object.Name = d.Get("name").(string)
 	object.MachineName = d.Get("machine_name").(string)
@@ -126,7 +125,6 @@ func dataSourceConnectorRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
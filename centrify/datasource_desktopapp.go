@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -117,8 +115,9 @@ func getDSDesktopAppSchema() map[string]*schema.Schema {
 }
 
 func dataSourceDesktopAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding DesktopApp")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding DesktopApp")
 	object := vault.NewDesktopApp(client)
 	object.Name = d.Get("name").(string)
 
@@ -133,7 +132,6 @@ func dataSourceDesktopAppRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -79,8 +77,9 @@ func getDSDirectoryObjectSchema() map[string]*schema.Schema {
 }
 
 func dataSourceDirectoryObjectRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding Directory Object")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding Directory Object")
 	object := vault.NewDirectoryObjects(client)
 	object.QueryName = d.Get("name").(string)
 	object.ObjectType = d.Get("object_type").(string)
@@ -4,9 +4,7 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/directoryservice"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -57,8 +55,9 @@ func getDSDirectoryServiceSchema() map[string]*schema.Schema {
 }
 
 func dataSourceDirectoryServiceRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding DirectoryService")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding DirectoryService")
 	object := vault.NewDirectoryServices(client)
 
 	err := object.Read()
@@ -95,7 +94,6 @@ func dataSourceDirectoryServiceRead(d *schema.ResourceData, m interface{}) error
 	}
 
 	var result = results[0]
-	//logger.Debugf("Found connector: %+v", result)
 	d.SetId(result.ID)
 	d.Set("name", result.Config)
 	d.Set("status", result.Status)
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -28,8 +26,9 @@ func getDSFederatedGroupSchema() map[string]*schema.Schema {
 }
 
 func dataSourceFederatedGroupRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding federated group")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding federated group")
 	object := vault.NewFederatedGroup(client)
 	object.Name = d.Get("name").(string)
 
@@ -43,7 +42,7 @@ func dataSourceFederatedGroupRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map: %+v", schemamap)
+	client.Logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
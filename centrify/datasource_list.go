@@ -0,0 +1,508 @@
+package centrify
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/centrify/terraform-provider-centrify/centrify/internal/hashcode"
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// listColumn maps attribute of plural data source to RedRock column
+type listColumn struct {
+	attribute   string
+	column      string
+	filter      bool // Whether objects can be filtered by exact value of the attribute
+	description string
+}
+
+// listCondition is fixed column value of objects listed by plural data source
+type listCondition struct {
+	column string
+	value  string
+}
+
+// listDataSource describes plural data source that lists objects of a RedRock table
+type listDataSource struct {
+	entity     string // Object type used in descriptions, e.g. system
+	entities   string // Plural of entity if it isn't entity followed by s
+	table      string
+	conditions []listCondition // Fixed column values, e.g. CollectionType of sets
+	nameColumn string          // Column matched by name_pattern and name_regex
+	columns    []listColumn
+	// fetch returns all objects of types that aren't in a RedRock table. Search attributes are applied to the returned rows
+	fetch func(client *restapi.RestClient) ([]map[string]interface{}, error)
+}
+
+func (def listDataSource) plural() string {
+	if def.entities != "" {
+		return def.entities
+	}
+	return def.entity + "s"
+}
+
+var (
+	listSystems = listDataSource{
+		entity:     "system",
+		table:      "Server",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the system"},
+			{"fqdn", "FQDN", true, "Hostname or IP address of the system"},
+			{"computer_class", "ComputerClass", true, "Type of the system"},
+			{"description", "Description", false, "Description of the system"},
+		},
+	}
+	listDomains = listDataSource{
+		entity:     "domain",
+		table:      "VaultDomain",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the domain"},
+			{"description", "Description", false, "Description of the domain"},
+		},
+	}
+	listDatabases = listDataSource{
+		entity:     "database",
+		table:      "VaultDatabase",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the database"},
+			{"fqdn", "FQDN", true, "Hostname or IP address of the database"},
+			{"database_class", "DatabaseClass", true, "Type of the database"},
+			{"description", "Description", false, "Description of the database"},
+		},
+	}
+	listAccounts = listDataSource{
+		entity:     "account",
+		table:      "VaultAccount",
+		nameColumn: "User",
+		columns: []listColumn{
+			{"name", "User", true, "Name of the account"},
+			{"host_id", "Host", true, "ID of the system the account belongs to"},
+			{"domain_id", "DomainID", true, "ID of the domain the account belongs to"},
+			{"database_id", "DatabaseID", true, "ID of the database the account belongs to"},
+			{"cloudprovider_id", "CloudProviderId", true, "ID of the cloud provider the account belongs to"},
+			{"description", "Description", false, "Description of the account"},
+		},
+	}
+	listSecrets = listDataSource{
+		entity:     "secret",
+		table:      "DataVault",
+		nameColumn: "SecretName",
+		columns: []listColumn{
+			{"secret_name", "SecretName", true, "Name of the secret"},
+			{"parent_path", "ParentPath", true, "Path of the folder the secret is in"},
+			{"folder_id", "FolderId", true, "ID of the folder the secret is in"},
+			{"type", "Type", true, "Type of the secret, Text or File"},
+			{"description", "Description", false, "Description of the secret"},
+		},
+	}
+	listSecretFolders = listDataSource{
+		entity:     "secret folder",
+		table:      "Sets",
+		conditions: []listCondition{{"ObjectType", "DataVault"}, {"CollectionType", "Phantom"}},
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the secret folder"},
+			{"parent_path", "ParentPath", true, "Path of the parent folder"},
+			{"description", "Description", false, "Description of the secret folder"},
+		},
+	}
+	listSSHKeys = listDataSource{
+		entity:     "SSH key",
+		table:      "SshKeys",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the SSH key"},
+			{"key_type", "KeyType", true, "Type of the SSH key"},
+			{"description", "Description", false, "Description of the SSH key"},
+		},
+	}
+	listManualSets = listDataSource{
+		entity:     "set",
+		table:      "Sets",
+		conditions: []listCondition{{"CollectionType", "ManualBucket"}},
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the set"},
+			{"type", "ObjectType", true, "Type of objects in the set"},
+			{"description", "Description", false, "Description of the set"},
+		},
+	}
+	listRoles = listDataSource{
+		entity:     "role",
+		table:      "Role",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the role"},
+			{"description", "Description", false, "Description of the role"},
+		},
+	}
+	listUsers = listDataSource{
+		entity:     "user",
+		table:      "User",
+		nameColumn: "Username",
+		columns: []listColumn{
+			{"username", "Username", true, "Login name of the user"},
+			{"display_name", "DisplayName", false, "Display name of the user"},
+			{"email", "Email", true, "Email address of the user"},
+		},
+	}
+	listWebApps = listDataSource{
+		entity:     "web app",
+		table:      "Application",
+		conditions: []listCondition{{"AppType", "Web"}},
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the web app"},
+			{"web_app_type", "WebAppType", true, "Type of the web app, one of Saml, OAuth, OpenIDConnect or UsernamePassword"},
+			{"template_name", "TemplateName", true, "Template the web app is created from"},
+			{"description", "Description", false, "Description of the web app"},
+		},
+	}
+	listDesktopApps = listDataSource{
+		entity:     "desktop app",
+		table:      "Application",
+		conditions: []listCondition{{"AppType", "Desktop"}},
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the desktop app"},
+			{"template_name", "TemplateName", true, "Template the desktop app is created from"},
+			{"description", "Description", false, "Description of the desktop app"},
+		},
+	}
+	listConnectors = listDataSource{
+		entity:     "connector",
+		table:      "Proxy",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the connector"},
+			{"machine_name", "MachineName", true, "Host name of the machine the connector runs on"},
+			{"dns_host_name", "DnsHostName", true, "DNS host name of the machine the connector runs on"},
+			{"version", "Version", true, "Version of the connector"},
+			{"vpc_identifier", "VpcIdentifier", true, "VPC identifier of the connector"},
+			{"online", "Online", false, "Whether the connector is online"},
+		},
+	}
+	listCloudProviders = listDataSource{
+		entity:     "cloud provider",
+		table:      "CloudProviders",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the cloud provider"},
+			{"cloud_account_id", "CloudAccountId", true, "Account ID of the cloud provider"},
+			{"type", "Type", true, "Type of the cloud provider"},
+			{"description", "Description", false, "Description of the cloud provider"},
+		},
+	}
+	listServices = listDataSource{
+		entity:     "service",
+		table:      "Subscriptions",
+		nameColumn: "WindowsServiceName",
+		columns: []listColumn{
+			{"service_name", "WindowsServiceName", true, "Name of the Windows service"},
+			{"system_id", "ComputerID", true, "ID of the system the service runs on"},
+			{"service_type", "Type", true, "Type of the service"},
+			{"description", "Description", false, "Description of the service"},
+		},
+	}
+	listMultiplexedAccounts = listDataSource{
+		entity:     "multiplexed account",
+		table:      "MultiplexedAccount",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the multiplexed account"},
+			{"active_account", "ActiveAccount", false, "Name of the account currently in use"},
+			{"description", "Description", false, "Description of the multiplexed account"},
+		},
+	}
+	listPolicies = listDataSource{
+		entity:     "policy",
+		entities:   "policies",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the policy"},
+			{"link_type", "LinkType", true, "How the policy is assigned, one of Global, Role, Collection or Inactive"},
+			{"description", "Description", false, "Description of the policy"},
+		},
+		fetch: func(client *restapi.RestClient) ([]map[string]interface{}, error) {
+			rows, err := vault.NewPolicy(client).QueryAll()
+			for _, row := range rows {
+				// Policy links refer to policies by path of their policy set
+				if set, ok := row["PolicySet"].(string); ok {
+					row["Name"] = strings.TrimPrefix(set, "/Policy/")
+				}
+			}
+			return rows, err
+		},
+	}
+	listAuthenticationProfiles = listDataSource{
+		entity:     "authentication profile",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the authentication profile"},
+			{"pass_through_duration", "DurationInMinutes", false, "Challenge pass-through duration in minutes"},
+		},
+		fetch: func(client *restapi.RestClient) ([]map[string]interface{}, error) {
+			rows, err := vault.NewAuthenticationProfile(client).QueryAll()
+			for _, row := range rows {
+				row["ID"] = row["Uuid"]
+			}
+			return rows, err
+		},
+	}
+	listPasswordProfiles = listDataSource{
+		entity:     "password profile",
+		nameColumn: "Name",
+		columns: []listColumn{
+			{"name", "Name", true, "Name of the password profile"},
+			{"profile_type", "ProfileType", true, "Type of the password profile, e.g. UserDefined"},
+			{"description", "Description", false, "Description of the password profile"},
+		},
+		fetch: func(client *restapi.RestClient) ([]map[string]interface{}, error) {
+			return vault.NewPasswordProfile(client).QueryAll()
+		},
+	}
+)
+
+func dataSourceList(def listDataSource) *schema.Resource {
+	itemSchema := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: fmt.Sprintf("ID of the %s", def.entity),
+		},
+	}
+	s := map[string]*schema.Schema{
+		"name_pattern": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Case insensitive glob pattern of the name. * matches any characters and ? matches one character",
+		},
+		"name_regex": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+			Description:  "Regular expression the name must match",
+		},
+		"max_rows": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      10000,
+			ValidateFunc: validation.IntAtLeast(1),
+			Description:  fmt.Sprintf("Maximum number of %s. Reading fails if more are found", def.plural()),
+		},
+		"ids": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+			Description: fmt.Sprintf("IDs of matched %s", def.plural()),
+		},
+		"items": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: itemSchema,
+			},
+			Description: fmt.Sprintf("Matched %s", def.plural()),
+		},
+		"total": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: fmt.Sprintf("Number of matched %s", def.plural()),
+		},
+	}
+	for _, c := range def.columns {
+		itemSchema[c.attribute] = &schema.Schema{
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: c.description,
+		}
+		if c.filter {
+			s[c.attribute] = &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: c.description,
+			}
+		}
+	}
+
+	return &schema.Resource{
+		Read: func(d *schema.ResourceData, m interface{}) error {
+			return dataSourceListRead(d, m, def)
+		},
+
+		Schema: s,
+	}
+}
+
+func dataSourceListRead(d *schema.ResourceData, m interface{}, def listDataSource) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Listing %s", def.plural())
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	maxRows := d.Get("max_rows").(int)
+	var ids []interface{}
+	var items []interface{}
+	add := func(row map[string]interface{}) error {
+		flattened, err := flattenQueryRow(row)
+		if err != nil {
+			return err
+		}
+		name, _ := flattened[def.nameColumn].(string)
+		if nameRegex != nil && !nameRegex.MatchString(name) {
+			return nil
+		}
+		if len(items) == maxRows {
+			return fmt.Errorf("more than max_rows %d %s found", maxRows, def.plural())
+		}
+		id, _ := flattened["ID"].(string)
+		item := map[string]interface{}{"id": id}
+		for _, c := range def.columns {
+			item[c.attribute], _ = flattened[c.column].(string)
+		}
+		ids = append(ids, id)
+		items = append(items, item)
+		return nil
+	}
+
+	var search string
+	var err error
+	if def.fetch != nil {
+		search, err = fetchList(client, d, def, add)
+	} else {
+		search, err = queryList(client, d, def, add)
+	}
+	if err != nil {
+		return fmt.Errorf("error listing %s: %v", def.plural(), err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(search + d.Get("name_regex").(string))))
+	d.Set("ids", ids)
+	d.Set("items", items)
+	d.Set("total", len(items))
+
+	return nil
+}
+
+// queryList passes rows of RedRock table matching search attributes to fn in name order. Returns the query script
+func queryList(client *restapi.RestClient, d *schema.ResourceData, def listDataSource, fn func(row map[string]interface{}) error) (string, error) {
+	query := vault.NewQueryBuilder(def.table).Select("ID")
+	for _, c := range def.columns {
+		query.Select(c.column)
+	}
+	for _, c := range def.conditions {
+		query.Equal(c.column, c.value)
+	}
+	for _, c := range def.columns {
+		if v, ok := d.GetOk(c.attribute); ok && c.filter {
+			query.Equal(c.column, v.(string))
+		}
+	}
+	if v, ok := d.GetOk("name_pattern"); ok {
+		query.Like(def.nameColumn, globToLike(v.(string)))
+	}
+	query.OrderBy(def.nameColumn, true)
+	script, err := query.Build()
+	if err != nil {
+		return "", err
+	}
+
+	return script, query.Iterate(client, 0).ForEach(fn)
+}
+
+// fetchList passes fetched rows matching search attributes to fn in name order. Returns description of the search
+func fetchList(client *restapi.RestClient, d *schema.ResourceData, def listDataSource, fn func(row map[string]interface{}) error) (string, error) {
+	search := def.plural()
+	var namePattern *regexp.Regexp
+	if v, ok := d.GetOk("name_pattern"); ok {
+		namePattern = regexp.MustCompile("(?is)^" + globToRegexp(v.(string)) + "$")
+		search += fmt.Sprintf(" name_pattern=%q", v)
+	}
+	for _, c := range def.columns {
+		if v, ok := d.GetOk(c.attribute); ok && c.filter {
+			search += fmt.Sprintf(" %s=%q", c.attribute, v)
+		}
+	}
+
+	rows, err := def.fetch(client)
+	if err != nil {
+		return "", err
+	}
+	type namedRow struct {
+		name string
+		row  map[string]interface{}
+	}
+	var matched []namedRow
+	for _, row := range rows {
+		flattened, err := flattenQueryRow(row)
+		if err != nil {
+			return "", err
+		}
+		name, _ := flattened[def.nameColumn].(string)
+		match := namePattern == nil || namePattern.MatchString(name)
+		for _, c := range def.columns {
+			if v, ok := d.GetOk(c.attribute); ok && c.filter && flattened[c.column] != v.(string) {
+				match = false
+			}
+		}
+		if match {
+			matched = append(matched, namedRow{name, row})
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return strings.ToLower(matched[i].name) < strings.ToLower(matched[j].name)
+	})
+
+	for _, m := range matched {
+		if err := fn(m.row); err != nil {
+			return "", err
+		}
+	}
+	return search, nil
+}
+
+// globToLike converts glob pattern to LIKE pattern
+func globToLike(glob string) string {
+	var b strings.Builder
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString("%")
+		case '?':
+			b.WriteString("_")
+		default:
+			b.WriteString(vault.EscapeLike(string(r)))
+		}
+	}
+	return b.String()
+}
+
+// globToRegexp converts glob pattern to regular expression
+func globToRegexp(glob string) string {
+	var b strings.Builder
+	for _, r := range glob {
+		switch r {
+		case '*':
+			b.WriteString(".*")
+		case '?':
+			b.WriteString(".")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,110 @@
+package centrify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDataSourceListRead(t *testing.T) {
+	var scripts []string
+	meta, server := redRockTenant(t, 25, &scripts)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceList(listSystems).Schema, map[string]interface{}{
+		"computer_class": "Unix",
+		"name_pattern":   "system_1*",
+		"name_regex":     "^system1[0-4]$",
+	})
+	if err := dataSourceListRead(d, meta, listSystems); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `SELECT ID, Name, FQDN, ComputerClass, Description FROM Server WHERE ComputerClass='Unix' AND Name LIKE 'system\_1%' ESCAPE '\' ORDER BY Name ASC`
+	if scripts[0] != expected {
+		t.Errorf("expected script %s, got %s", expected, scripts[0])
+	}
+	ids := d.Get("ids").([]interface{})
+	if len(ids) != 5 || d.Get("total").(int) != 5 || ids[0] != "id10" || ids[4] != "id14" {
+		t.Errorf("expected id10 to id14, got %v", ids)
+	}
+	if name := d.Get("items.2.name").(string); name != "system12" {
+		t.Errorf("expected items.2.name system12, got %s", name)
+	}
+}
+
+func TestDataSourceListMaxRows(t *testing.T) {
+	var scripts []string
+	meta, server := redRockTenant(t, 25, &scripts)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceList(listRoles).Schema, map[string]interface{}{"max_rows": 10})
+	if err := dataSourceListRead(d, meta, listRoles); err == nil || !strings.Contains(err.Error(), "max_rows") {
+		t.Errorf("expected max_rows error, got %v", err)
+	}
+}
+
+func TestDataSourceListFetched(t *testing.T) {
+	plink := func(id, name, linkType string) map[string]interface{} {
+		return map[string]interface{}{"Row": map[string]interface{}{"ID": id, "PolicySet": "/Policy/" + name, "LinkType": linkType}}
+	}
+	meta, server := testTenant(t, map[string]restapitest.Handler{
+		"/Policy/GetNicePlinks": restapitest.Result(map[string]interface{}{
+			"RevStamp": "1",
+			"Results": []interface{}{
+				plink("p1", "Web Servers", "Collection"),
+				plink("p2", "web admins", "Role"),
+				plink("p3", "Default", "Global"),
+				plink("p4", "Web.Inactive", "Inactive"),
+			},
+		}),
+	})
+	defer server.Close()
+
+	cases := []struct {
+		config map[string]interface{}
+		ids    []string
+	}{
+		{map[string]interface{}{}, []string{"p3", "p2", "p1", "p4"}},
+		{map[string]interface{}{"name_pattern": "WEB *"}, []string{"p2", "p1"}},
+		{map[string]interface{}{"name_pattern": "web.*", "link_type": "Inactive"}, []string{"p4"}},
+		{map[string]interface{}{"name_regex": "^Web"}, []string{"p1", "p4"}},
+	}
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceList(listPolicies).Schema, tc.config)
+		if err := dataSourceListRead(d, meta, listPolicies); err != nil {
+			t.Fatal(err)
+		}
+		var ids []string
+		for _, id := range d.Get("ids").([]interface{}) {
+			ids = append(ids, id.(string))
+		}
+		if strings.Join(ids, ",") != strings.Join(tc.ids, ",") {
+			t.Errorf("%v: expected %v, got %v", tc.config, tc.ids, ids)
+		}
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceList(listPolicies).Schema, map[string]interface{}{"name": "Default"})
+	if err := dataSourceListRead(d, meta, listPolicies); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("items.0.name").(string) != "Default" || d.Get("items.0.link_type").(string) != "Global" {
+		t.Errorf("unexpected items %v", d.Get("items"))
+	}
+}
+
+func TestGlobToLike(t *testing.T) {
+	cases := map[string]string{
+		"web-*":      "web-%",
+		"db?.prod":   "db_.prod",
+		"100%_done*": `100\%\_done%`,
+		`a\b`:        `a\\b`,
+	}
+	for glob, expected := range cases {
+		if like := globToLike(glob); like != expected {
+			t.Errorf("%s: expected %s, got %s", glob, expected, like)
+		}
+	}
+}
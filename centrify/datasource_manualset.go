@@ -4,9 +4,7 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -71,8 +69,9 @@ func getDSManualSetSchema() map[string]*schema.Schema {
 }
 
 func dataSourceManualSetRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding Manual Set")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding Manual Set")
 	object := vault.NewManualSet(client)
 	object.Name = d.Get("name").(string)
 	object.ObjectType = d.Get("type").(string)
@@ -88,7 +87,6 @@ func dataSourceManualSetRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -72,8 +70,9 @@ func getDSMultiplexedAccountSchema() map[string]*schema.Schema {
 }
 
 func dataSourceMultiplexedAccountRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding multiplexed account")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding multiplexed account")
 	object := vault.NewMultiplexedAccount(client)
 	object.Name = d.Get("name").(string)
 
@@ -87,7 +86,6 @@ func dataSourceMultiplexedAccountRead(d *schema.ResourceData, m interface{}) err
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
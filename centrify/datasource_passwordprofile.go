@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -113,8 +111,9 @@ func getDSPasswordProfileSchema() map[string]*schema.Schema {
 }
 
 func dataSourcePasswordProfileRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding password profile")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding password profile")
 	object := vault.NewPasswordProfile(client)
 	object.Name = d.Get("name").(string)
 	object.ProfileType = d.Get("profile_type").(string)
@@ -129,7 +128,6 @@ func dataSourcePasswordProfileRead(d *schema.ResourceData, m interface{}) error
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -83,8 +81,9 @@ func getDSPolicySchema() map[string]*schema.Schema {
 }
 
 func dataSourcePolicyRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding policy")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding policy")
 	object := vault.NewPolicy(client)
 	object.Name = d.Get("name").(string)
 
@@ -98,7 +97,7 @@ func dataSourcePolicyRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map: %+v", schemamap)
+	client.Logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "plink":
@@ -135,7 +134,6 @@ func dataSourcePolicyRead(d *schema.ResourceData, m interface{}) error {
 			return fmt.Errorf("error retrieving policy with name '%s': %s", object.Name, err)
 		}
 
-		//logger.Debugf("Found user: %+v", result)
 		d.SetId(result["ID"].(string))
 		d.Set("description", result["Description"].(string))
 		d.Set("link_type", result["LinkType"].(string))
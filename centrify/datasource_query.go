@@ -0,0 +1,135 @@
+package centrify
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/centrify/terraform-provider-centrify/centrify/internal/hashcode"
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceQuery() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceQueryRead,
+
+		Schema: map[string]*schema.Schema{
+			"script": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "RedRock SELECT statement. Arguments are referenced as @name",
+			},
+			"args": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "Named arguments of the script",
+			},
+			"page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      vault.DefaultPageSize,
+				ValidateFunc: validation.IntBetween(1, 10000),
+				Description:  "Number of rows fetched per request",
+			},
+			"max_rows": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10000,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of rows. Reading fails if the query returns more rows",
+			},
+			"rows": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				Description: "Rows returned by the query",
+			},
+			"total": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of rows returned by the query",
+			},
+		},
+	}
+}
+
+func dataSourceQueryRead(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Running RedRock query")
+
+	args := make(map[string]interface{})
+	for k, v := range d.Get("args").(map[string]interface{}) {
+		args[k] = v.(string)
+	}
+	script, err := vault.PrepareScript(d.Get("script").(string), args)
+	if err != nil {
+		return fmt.Errorf("invalid query script: %v", err)
+	}
+
+	maxRows := d.Get("max_rows").(int)
+	it := vault.NewRowIterator(client, script, d.Get("page_size").(int))
+	total, err := it.Total()
+	if err != nil {
+		return fmt.Errorf("error running query '%s': %v", script, err)
+	}
+	if total > maxRows {
+		return fmt.Errorf("query returns %d rows which exceeds max_rows %d", total, maxRows)
+	}
+
+	var rows []interface{}
+	err = it.ForEach(func(row map[string]interface{}) error {
+		if len(rows) == maxRows {
+			return fmt.Errorf("query returns more than max_rows %d rows", maxRows)
+		}
+		flattened, err := flattenQueryRow(row)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, flattened)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error running query '%s': %v", script, err)
+	}
+
+	d.SetId(strconv.Itoa(hashcode.String(script)))
+	d.Set("rows", rows)
+	d.Set("total", len(rows))
+
+	return nil
+}
+
+// flattenQueryRow converts row values to strings. Nested values are converted to JSON
+func flattenQueryRow(row map[string]interface{}) (map[string]interface{}, error) {
+	flattened := make(map[string]interface{})
+	for k, v := range row {
+		switch value := v.(type) {
+		case nil:
+			flattened[k] = ""
+		case string:
+			flattened[k] = value
+		case bool:
+			flattened[k] = strconv.FormatBool(value)
+		case float64:
+			flattened[k] = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			b, err := json.Marshal(value)
+			if err != nil {
+				return nil, fmt.Errorf("error converting column %s: %v", k, err)
+			}
+			flattened[k] = string(b)
+		}
+	}
+	return flattened, nil
+}
@@ -0,0 +1,96 @@
+package centrify
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// redRockTenant serves rows systems a page at a time, or all at once to unpaged queries, and records scripts it receives
+func redRockTenant(t *testing.T, rows int, scripts *[]string) (*providerMeta, *httptest.Server) {
+	query := func(r *restapitest.Request) interface{} {
+		var body struct {
+			Script string
+			Args   struct{ PageNumber, PageSize int }
+		}
+		if err := r.Decode(&body); err != nil {
+			t.Errorf("decoding request: %v", err)
+		}
+		*scripts = append(*scripts, body.Script)
+		if body.Args.PageSize == 0 {
+			// Unpaged query returns all rows
+			body.Args.PageNumber, body.Args.PageSize = 1, rows
+		}
+		results := []interface{}{}
+		for i := (body.Args.PageNumber - 1) * body.Args.PageSize; i < rows && i < body.Args.PageNumber*body.Args.PageSize; i++ {
+			results = append(results, map[string]interface{}{"Row": map[string]interface{}{
+				"ID":        fmt.Sprintf("id%d", i),
+				"Name":      fmt.Sprintf("system%d", i),
+				"Port":      22,
+				"ProxyUser": nil,
+			}})
+		}
+		return map[string]interface{}{"Results": results, "FullCount": rows}
+	}
+	return testTenant(t, map[string]restapitest.Handler{
+		"/RedRock/query": query,
+		"/Redrock/Query": query,
+	})
+}
+
+func TestDataSourceQueryRead(t *testing.T) {
+	var scripts []string
+	meta, server := redRockTenant(t, 5, &scripts)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, dataSourceQuery().Schema, map[string]interface{}{
+		"script":    "SELECT ID, Name FROM Server WHERE ComputerClass=@class",
+		"args":      map[string]interface{}{"class": "Unix' OR '1'='1"},
+		"page_size": 2,
+	})
+	if err := dataSourceQueryRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(scripts) != 3 {
+		t.Errorf("expected 3 page requests, got %d", len(scripts))
+	}
+	if expected := `SELECT ID, Name FROM Server WHERE ComputerClass='Unix'' OR ''1''=''1'`; scripts[0] != expected {
+		t.Errorf("expected script %s, got %s", expected, scripts[0])
+	}
+	rows := d.Get("rows").([]interface{})
+	if len(rows) != 5 || d.Get("total").(int) != 5 {
+		t.Fatalf("expected 5 rows, got %d (total %d)", len(rows), d.Get("total").(int))
+	}
+	row := rows[4].(map[string]interface{})
+	if row["Name"] != "system4" || row["Port"] != "22" || row["ProxyUser"] != "" {
+		t.Errorf("unexpected row %+v", row)
+	}
+}
+
+func TestDataSourceQueryGuards(t *testing.T) {
+	var scripts []string
+	meta, server := redRockTenant(t, 5, &scripts)
+	defer server.Close()
+
+	cases := map[string]map[string]interface{}{
+		"max_rows":         {"script": "SELECT * FROM Server", "max_rows": 4},
+		"not select":       {"script": "DELETE FROM Server"},
+		"missing argument": {"script": "SELECT * FROM Server WHERE Name=@name"},
+	}
+	for name, raw := range cases {
+		d := schema.TestResourceDataRaw(t, dataSourceQuery().Schema, raw)
+		if err := dataSourceQueryRead(d, meta); err == nil {
+			t.Errorf("%s: expected error", name)
+		} else if name == "max_rows" && !strings.Contains(err.Error(), "max_rows") {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+	}
+	if len(scripts) != 1 {
+		t.Errorf("expected invalid scripts not to be sent, got %d requests", len(scripts))
+	}
+}
@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -101,8 +99,9 @@ func getDSServiceSchema() map[string]*schema.Schema {
 }
 
 func dataSourceServiceRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding Service")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding Service")
 	object := vault.NewService(client)
 	object.Name = d.Get("service_name").(string)
 
@@ -117,7 +116,6 @@ func dataSourceServiceRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		if k == "days_of_week" {
 			// Convert "value1,value1" to schema.TypeSet
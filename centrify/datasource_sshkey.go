@@ -4,9 +4,7 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/keypairtype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -87,8 +85,9 @@ func getDSSSHKeySchema() map[string]*schema.Schema {
 }
 
 func dataSourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding SSH Key")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding SSH Key")
 	object := vault.NewSSHKey(client)
 	object.Name = d.Get("name").(string)
 	if v, ok := d.GetOk("key_pair_type"); ok {
@@ -111,7 +110,6 @@ func dataSourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
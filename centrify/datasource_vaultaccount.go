@@ -4,9 +4,7 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/keypairtype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -102,7 +100,7 @@ func getDSAccountSchema() map[string]*schema.Schema {
 		"checkin": {
 			Type:        schema.TypeBool,
 			Optional:    true,
-			Description: "Whether to checkin the password immediately after checkout",
+			Description: "Whether to checkin the password immediately after checkout. Otherwise it is checked in when Terraform completes",
 		},
 		"credential_type": {
 			Type:        schema.TypeString,
@@ -176,8 +174,9 @@ func getDSAccountSchema() map[string]*schema.Schema {
 }
 
 func dataSourceAccountRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding vault account")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding vault account")
 	object := vault.NewAccount(client)
 	object.User = d.Get("name").(string)
 	if v, ok := d.GetOk("host_id"); ok {
@@ -203,7 +202,6 @@ func dataSourceAccountRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule", "access_secret_checkout_rule":
@@ -228,11 +226,18 @@ func dataSourceAccountRead(d *schema.ResourceData, m interface{}) error {
 	if d.Get("checkout").(bool) {
 		switch object.CredentialType {
 		case "Password":
-			pw, err := object.CheckoutPassword(d.Get("checkin").(bool))
+			// Checkout that isn't checked in immediately is checked in when provider exits or is interrupted.
+			// Context of client is cancelled as soon as read completes, so provider context is used
+			lease, err := object.CheckoutPasswordLease(m.(*providerMeta).client.Context(), false)
 			if err != nil {
 				return err
 			}
-			d.Set("password", pw)
+			if d.Get("checkin").(bool) {
+				if err := lease.Close(); err != nil {
+					return err
+				}
+			}
+			d.Set("password", lease.Password)
 		case "SshKey":
 			//object.CredentialID = result["CredentialId"].(string)
 			sshkey := vault.NewSSHKey(client)
@@ -5,9 +5,7 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/databaseclass"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -132,8 +130,9 @@ func getDSDatabaseSchema() map[string]*schema.Schema {
 }
 
 func dataSourceDatabaseRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding database")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding database")
 	object := vault.NewDatabase(client)
 	object.Name = d.Get("name").(string)
 	object.FQDN = d.Get("hostname").(string)
@@ -157,7 +156,6 @@ func dataSourceDatabaseRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		if k == "connector_list" {
 			// Convert "value1,value1" to schema.TypeSet
@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -190,8 +188,9 @@ func getDSDomainSchema() map[string]*schema.Schema {
 }
 
 func dataSourceDomainRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding domain")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding domain")
 	object := vault.NewDomain(client)
 	object.Name = d.Get("name").(string)
 
@@ -205,7 +204,6 @@ func dataSourceDomainRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "connector_list":
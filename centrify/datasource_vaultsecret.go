@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -80,8 +78,9 @@ func getDSSecretSchema() map[string]*schema.Schema {
 }
 
 func dataSourceSecretRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding vault secret")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding vault secret")
 	object := vault.NewSecret(client)
 	object.SecretName = d.Get("secret_name").(string)
 	if v, ok := d.GetOk("parent_path"); ok {
@@ -101,7 +100,6 @@ func dataSourceSecretRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -58,8 +56,9 @@ func getDSSecretFolderSchema() map[string]*schema.Schema {
 }
 
 func dataSourceSecretFolderRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding SecretFolder")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding SecretFolder")
 	object := vault.NewSecretFolder(client)
 	object.Name = d.Get("name").(string)
 	if v, ok := d.GetOk("parent_path"); ok {
@@ -76,7 +75,6 @@ func dataSourceSecretFolderRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
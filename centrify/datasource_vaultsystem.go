@@ -5,9 +5,7 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/computerclass"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -284,8 +282,9 @@ func getDSSystemSchema() map[string]*schema.Schema {
 }
 
 func dataSourceSystemRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding system")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding system")
 	object := vault.NewSystem(client)
 	object.Name = d.Get("name").(string)
 	object.FQDN = d.Get("fqdn").(string)
@@ -303,7 +302,6 @@ func dataSourceSystemRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "connector_list":
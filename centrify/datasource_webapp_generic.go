@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -152,8 +150,9 @@ func getDSGenericWebAppSchema() map[string]*schema.Schema {
 }
 
 func dataSourceGenericWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding Generic webapp")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding Generic webapp")
 	object := vault.NewGenericWebApp(client)
 	object.Name = d.Get("name").(string)
 
@@ -168,7 +167,6 @@ func dataSourceGenericWebAppRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -4,9 +4,7 @@ import (
 	"fmt"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -64,8 +62,9 @@ func getDSOauthWebAppSchema() map[string]*schema.Schema {
 }
 
 func dataSourceOauthWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding Oauth webapp")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding Oauth webapp")
 	object := vault.NewOauthWebApp(client)
 	object.Name = d.Get("name").(string)
 	object.ApplicationID = d.Get("application_id").(string)
@@ -80,7 +79,6 @@ func dataSourceOauthWebAppRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "oauth_profile":
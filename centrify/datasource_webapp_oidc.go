@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -92,8 +90,9 @@ func getDSOidcWebAppSchema() map[string]*schema.Schema {
 }
 
 func dataSourceOidcWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding Oidc webapp")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding Oidc webapp")
 	object := vault.NewOidcWebApp(client)
 	object.Name = d.Get("name").(string)
 	object.ApplicationID = d.Get("application_id").(string)
@@ -109,7 +108,6 @@ func dataSourceOidcWebAppRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "oauth_profile":
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -161,8 +159,9 @@ func getDSSamlWebAppSchema() map[string]*schema.Schema {
 }
 
 func dataSourceSamlWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Finding Saml webapp")
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Finding Saml webapp")
 	object := vault.NewSamlWebApp(client)
 	object.Name = d.Get("name").(string)
 	if v, ok := d.GetOk("application_id"); ok {
@@ -188,7 +187,6 @@ func dataSourceSamlWebAppRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	//logger.Debugf("Generated Map: %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -0,0 +1,169 @@
+package centrify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/resourcetype"
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// nameLookup resolves natural key of an object to its ID
+type nameLookup func(client *restapi.RestClient, key string) (string, error)
+
+// importStateByName returns import function that accepts either object ID or natural key in the form of
+// <prefix>/<key>, e.g. system/<name>. Natural key is resolved to ID through lookup
+func importStateByName(prefix string, format string, lookup nameLookup) schema.StateFunc {
+	return func(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+		key := strings.TrimPrefix(d.Id(), prefix+"/")
+		if key == d.Id() {
+			// Not a natural key, import by ID
+			return []*schema.ResourceData{d}, nil
+		}
+
+		client, cancel := getRestClient(d, m, schema.TimeoutRead)
+		defer cancel()
+		client.Logger.Infof("Importing %s by name: %s", prefix, key)
+
+		id, err := lookup(client, key)
+		if err != nil {
+			if vault.IsAmbiguous(err) {
+				return nil, fmt.Errorf("%s '%s' matches more than one object, import it by ID instead: %v", prefix, key, err)
+			}
+			return nil, fmt.Errorf("error importing %s '%s', expected format %s/%s: %v", prefix, key, prefix, format, err)
+		}
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+// splitImportKey splits key into n non-empty parts separated by /. The last part may contain /
+func splitImportKey(key string, n int) ([]string, error) {
+	parts := strings.SplitN(key, "/", n)
+	if len(parts) != n {
+		return nil, fmt.Errorf("expected %d parts separated by /, got %d", n, len(parts))
+	}
+	for _, p := range parts {
+		if p == "" {
+			return nil, fmt.Errorf("empty part in '%s'", key)
+		}
+	}
+	return parts, nil
+}
+
+// splitFolderPath splits path/name into parent folder path and name. Folders in path are separated by /
+func splitFolderPath(key string) (string, string) {
+	var parentPath string
+	name := key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		parentPath = strings.ReplaceAll(key[:i], "/", "\\")
+		name = key[i+1:]
+	}
+	return parentPath, name
+}
+
+func lookupSystemID(client *restapi.RestClient, key string) (string, error) {
+	// System.GetIDByName requires computer class, query by name only instead
+	object := vault.NewSystem(client)
+	object.Name = key
+	result, err := object.Query()
+	if err != nil {
+		return "", err
+	}
+	return result["ID"].(string), nil
+}
+
+func lookupDomainID(client *restapi.RestClient, key string) (string, error) {
+	object := vault.NewDomain(client)
+	object.Name = key
+	return object.GetIDByName()
+}
+
+func lookupDatabaseID(client *restapi.RestClient, key string) (string, error) {
+	object := vault.NewDatabase(client)
+	object.Name = key
+	return object.GetIDByName()
+}
+
+func lookupCloudProviderID(client *restapi.RestClient, key string) (string, error) {
+	parts, err := splitImportKey(key, 2)
+	if err != nil {
+		return "", err
+	}
+	object := vault.NewCloudProvider(client)
+	object.CloudAccountID = parts[0]
+	object.Name = parts[1]
+	return object.GetIDByName()
+}
+
+func lookupAccountID(client *restapi.RestClient, key string) (string, error) {
+	parts, err := splitImportKey(key, 3)
+	if err != nil {
+		return "", err
+	}
+	switch parts[0] {
+	case resourcetype.System.String(), resourcetype.Database.String(), resourcetype.Domain.String(), resourcetype.CloudProvider.String():
+	default:
+		return "", fmt.Errorf("invalid resource type '%s', must be system, database, domain or cloudprovider", parts[0])
+	}
+	object := vault.NewAccount(client)
+	object.ResourceType = parts[0]
+	object.ResourceName = parts[1]
+	object.User = parts[2]
+	return object.GetIDByName()
+}
+
+func lookupSecretID(client *restapi.RestClient, key string) (string, error) {
+	object := vault.NewSecret(client)
+	object.ParentPath, object.SecretName = splitFolderPath(key)
+	return object.GetIDByName()
+}
+
+func lookupSecretFolderID(client *restapi.RestClient, key string) (string, error) {
+	object := vault.NewSecretFolder(client)
+	object.ParentPath, object.Name = splitFolderPath(key)
+	return object.GetIDByName()
+}
+
+func lookupManualSetID(client *restapi.RestClient, key string) (string, error) {
+	parts, err := splitImportKey(key, 2)
+	if err != nil {
+		return "", err
+	}
+	object := vault.NewManualSet(client)
+	object.ObjectType = parts[0]
+	object.Name = parts[1]
+	return object.GetIDByName()
+}
+
+func lookupDynamicSetID(client *restapi.RestClient, key string) (string, error) {
+	parts, err := splitImportKey(key, 2)
+	if err != nil {
+		return "", err
+	}
+	object := vault.NewDynamicSet(client)
+	object.ObjectType = parts[0]
+	object.Name = parts[1]
+	return object.GetIDByName()
+}
+
+func lookupRoleID(client *restapi.RestClient, key string) (string, error) {
+	object := vault.NewRole(client)
+	object.Name = key
+	return object.GetIDByName()
+}
+
+func lookupUserID(client *restapi.RestClient, key string) (string, error) {
+	object := vault.NewUser(client)
+	object.Name = key
+	return object.GetIDByName()
+}
+
+func lookupSSHKeyID(client *restapi.RestClient, key string) (string, error) {
+	object := vault.NewSSHKey(client)
+	object.Name = key
+	return object.GetIDByName()
+}
@@ -0,0 +1,88 @@
+package centrify
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestImportByName(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		id       string
+		scripts  []string
+	}{
+		{"id", resourceRole(), "xxxxxxxx-xxxx", nil},
+		{"role", resourceRole(), "role/O'Brien Admins", []string{"SELECT * FROM Role WHERE Name='O''Brien Admins'"}},
+		{"system", resourceSystem(), "system/web01", []string{"SELECT * FROM Server WHERE Name='web01'"}},
+		{
+			"account",
+			resourceAccount(),
+			"account/system/web01/root",
+			[]string{
+				"SELECT * FROM Server WHERE Name='web01'",
+				"SELECT * FROM VaultAccount WHERE User='root' AND Host='id0'",
+			},
+		},
+		{
+			"secret",
+			resourceSecret(),
+			"secret/Level 1/Level 2/password",
+			[]string{`SELECT * FROM DataVault WHERE SecretName='password' AND ParentPath='Level 1\Level 2'`},
+		},
+		{"set", resourceManualSet(), "set/Server/Linux Servers", []string{"SELECT * FROM Sets WHERE ObjectType='Server' AND CollectionType='ManualBucket' AND Name='Linux Servers'"}},
+		{"dynamic set", resourceDynamicSet(), "set/Server/Web Servers", []string{"SELECT * FROM Sets WHERE ObjectType='Server' AND CollectionType='SqlDynamic' AND Name='Web Servers'"}},
+	}
+	for _, tc := range cases {
+		var scripts []string
+		meta, server := redRockTenant(t, 1, &scripts)
+
+		d := tc.resource.TestResourceData()
+		d.SetId(tc.id)
+		imported, err := tc.resource.Importer.State(d, meta)
+		server.Close()
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+			continue
+		}
+		expectedID := "id0"
+		if tc.scripts == nil {
+			expectedID = tc.id
+		}
+		if len(imported) != 1 || imported[0].Id() != expectedID {
+			t.Errorf("%s: expected ID %s, got %s", tc.name, expectedID, imported[0].Id())
+		}
+		if strings.Join(scripts, "\n") != strings.Join(tc.scripts, "\n") {
+			t.Errorf("%s: expected scripts %q, got %q", tc.name, tc.scripts, scripts)
+		}
+	}
+}
+
+func TestImportByNameErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		resource *schema.Resource
+		id       string
+		rows     int
+		message  string
+	}{
+		{"ambiguous", resourceRole(), "role/Admins", 2, "matches more than one object"},
+		{"not found", resourceUser(), "user/nobody@example.com", 0, "returns 0 object"},
+		{"missing part", resourceAccount(), "account/system/root", 1, "expected format account/<resourcetype>/<resource>/<user>"},
+		{"resource type", resourceAccount(), "account/server/web01/root", 1, "invalid resource type"},
+	}
+	for _, tc := range cases {
+		var scripts []string
+		meta, server := redRockTenant(t, tc.rows, &scripts)
+
+		d := tc.resource.TestResourceData()
+		d.SetId(tc.id)
+		_, err := tc.resource.Importer.State(d, meta)
+		server.Close()
+		if err == nil || !strings.Contains(err.Error(), tc.message) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.name, tc.message, err)
+		}
+	}
+}
@@ -1,18 +1,16 @@
 package centrify
 
 import (
+	"context"
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
-var logPath string
-
 // Provider returns a schema.Provider for Centrify Platform.
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"url": {
 				Type:        schema.TypeString,
@@ -56,6 +54,38 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"CENTRIFY_USEDMC", "VAULT_USEDMC"}, false),
 				Description: "Whether to use DMC",
 			},
+			"auth_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"CENTRIFY_AUTHTYPE", "VAULT_AUTHTYPE"}, nil),
+				Description: "Authentication type. Derived from use_dmc and token if it isn't set",
+				ValidateFunc: validation.StringInSlice([]string{
+					authTypeOAuth,
+					authTypeOAuthToken,
+					authTypeDMC,
+					authTypeUnPw,
+				}, false),
+			},
+			"mfa_totp_secret": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"CENTRIFY_MFATOTPSECRET", "VAULT_MFATOTPSECRET"}, ""),
+				Description: "Base32 secret used to generate OATH one time password if auth_type is unpw",
+			},
+			"mfa_answers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Answers of MFA challenges keyed by mechanism name or security question if auth_type is unpw",
+			},
+			"mfa_mechanisms": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "MFA mechanisms in order of preference if auth_type is unpw",
+			},
 			"logpath": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -81,6 +111,48 @@ func Provider() *schema.Provider {
 				}, false),
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"CENTRIFY_LOGLEVEL", "VAULT_LOGLEVEL"}, "Error"),
 			},
+			"retry_max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"CENTRIFY_RETRYMAXATTEMPTS", "VAULT_RETRYMAXATTEMPTS"}, 3),
+				Description:  "Maximum number of attempts for API calls that fail with transient error. Set to 1 to disable retry",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retry_min_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"CENTRIFY_RETRYMINBACKOFF", "VAULT_RETRYMINBACKOFF"}, 1),
+				Description:  "Minimum wait time in seconds before retrying a failed API call",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_max_backoff": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"CENTRIFY_RETRYMAXBACKOFF", "VAULT_RETRYMAXBACKOFF"}, 30),
+				Description:  "Maximum wait time in seconds before retrying a failed API call",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"rate_limit": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"CENTRIFY_RATELIMIT", "VAULT_RATELIMIT"}, 0),
+				Description:  "Maximum number of API calls per second. 0 means unlimited",
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"rate_burst": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"CENTRIFY_RATEBURST", "VAULT_RATEBURST"}, 1),
+				Description:  "Maximum number of API calls that can be sent at once before rate_limit applies",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"max_in_flight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.MultiEnvDefaultFunc([]string{"CENTRIFY_MAXINFLIGHT", "VAULT_MAXINFLIGHT"}, 0),
+				Description:  "Maximum number of concurrent API calls. 0 means unlimited",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"centrifyvault_user":                  dataSourceUser_deprecated(),
@@ -108,31 +180,51 @@ func Provider() *schema.Provider {
 			"centrifyvault_webapp_oidc":           dataSourceOidcWebApp_deprecated(),
 			"centrifyvault_webapp_generic":        dataSourceGenericWebApp_deprecated(),
 			// Change centrifyvault_* centrify_*
-			"centrify_user":                  dataSourceUser(),
-			"centrify_role":                  dataSourceRole(),
-			"centrify_policy":                dataSourcePolicy(),
-			"centrify_manualset":             dataSourceManualSet(),
-			"centrify_passwordprofile":       dataSourcePasswordProfile(),
-			"centrify_authenticationprofile": dataSourceAuthenticationProfile(),
-			"centrify_connector":             dataSourceConnector(),
-			"centrify_domain":                dataSourceDomain(),
-			"centrify_system":                dataSourceSystem(),
-			"centrify_database":              dataSourceDatabase(),
-			"centrify_account":               dataSourceAccount(),
-			"centrify_secret":                dataSourceSecret(),
-			"centrify_secretfolder":          dataSourceSecretFolder(),
-			"centrify_sshkey":                dataSourceSSHKey(),
-			"centrify_desktopapp":            dataSourceDesktopApp(),
-			"centrify_directoryservice":      dataSourceDirectoryService(),
-			"centrify_directoryobject":       dataSourceDirectoryObject(),
-			"centrify_multiplexedaccount":    dataSourceMultiplexedAccount(),
-			"centrify_service":               dataSourceService(),
-			"centrify_cloudprovider":         dataSourceCloudProvider(),
-			"centrify_webapp_saml":           dataSourceSamlWebApp(),
-			"centrify_webapp_oauth":          dataSourceOauthWebApp(),
-			"centrify_webapp_oidc":           dataSourceOidcWebApp(),
-			"centrify_webapp_generic":        dataSourceGenericWebApp(),
-			"centrify_federatedgroup":        dataSourceFederatedGroup(),
+			"centrify_user":                   dataSourceUser(),
+			"centrify_role":                   dataSourceRole(),
+			"centrify_policy":                 dataSourcePolicy(),
+			"centrify_manualset":              dataSourceManualSet(),
+			"centrify_passwordprofile":        dataSourcePasswordProfile(),
+			"centrify_authenticationprofile":  dataSourceAuthenticationProfile(),
+			"centrify_connector":              dataSourceConnector(),
+			"centrify_domain":                 dataSourceDomain(),
+			"centrify_system":                 dataSourceSystem(),
+			"centrify_database":               dataSourceDatabase(),
+			"centrify_account":                dataSourceAccount(),
+			"centrify_secret":                 dataSourceSecret(),
+			"centrify_secretfolder":           dataSourceSecretFolder(),
+			"centrify_sshkey":                 dataSourceSSHKey(),
+			"centrify_desktopapp":             dataSourceDesktopApp(),
+			"centrify_directoryservice":       dataSourceDirectoryService(),
+			"centrify_directoryobject":        dataSourceDirectoryObject(),
+			"centrify_multiplexedaccount":     dataSourceMultiplexedAccount(),
+			"centrify_service":                dataSourceService(),
+			"centrify_cloudprovider":          dataSourceCloudProvider(),
+			"centrify_webapp_saml":            dataSourceSamlWebApp(),
+			"centrify_webapp_oauth":           dataSourceOauthWebApp(),
+			"centrify_webapp_oidc":            dataSourceOidcWebApp(),
+			"centrify_webapp_generic":         dataSourceGenericWebApp(),
+			"centrify_federatedgroup":         dataSourceFederatedGroup(),
+			"centrify_query":                  dataSourceQuery(),
+			"centrify_systems":                dataSourceList(listSystems),
+			"centrify_domains":                dataSourceList(listDomains),
+			"centrify_databases":              dataSourceList(listDatabases),
+			"centrify_accounts":               dataSourceList(listAccounts),
+			"centrify_secrets":                dataSourceList(listSecrets),
+			"centrify_secretfolders":          dataSourceList(listSecretFolders),
+			"centrify_sshkeys":                dataSourceList(listSSHKeys),
+			"centrify_manualsets":             dataSourceList(listManualSets),
+			"centrify_roles":                  dataSourceList(listRoles),
+			"centrify_users":                  dataSourceList(listUsers),
+			"centrify_webapps":                dataSourceList(listWebApps),
+			"centrify_desktopapps":            dataSourceList(listDesktopApps),
+			"centrify_connectors":             dataSourceList(listConnectors),
+			"centrify_cloudproviders":         dataSourceList(listCloudProviders),
+			"centrify_services":               dataSourceList(listServices),
+			"centrify_multiplexedaccounts":    dataSourceList(listMultiplexedAccounts),
+			"centrify_policies":               dataSourceList(listPolicies),
+			"centrify_authenticationprofiles": dataSourceList(listAuthenticationProfiles),
+			"centrify_passwordprofiles":       dataSourceList(listPasswordProfiles),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"centrifyvault_user":                      resourceUser_deprecated(),
@@ -169,9 +261,14 @@ func Provider() *schema.Provider {
 			"centrify_userpassword":          resourceUserPassword(),
 			"centrify_role":                  resourceRole(),
 			"centrify_role_membership":       resourceRoleMembership(),
+			"centrify_role_adminrights":      resourceRoleAdminRights(),
 			"centrify_policyorder":           resourcePolicyLinks(),
 			"centrify_policy":                resourcePolicy(),
 			"centrify_manualset":             resourceManualSet(),
+			"centrify_dynamicset":            resourceDynamicSet(),
+			"centrify_set_membership":        resourceSetMembership(),
+			"centrify_credential_file":       resourceCredentialFile(),
+			"centrify_account_rotation":      resourceAccountRotation(),
 			"centrify_passwordprofile":       resourcePasswordProfile(),
 			"centrify_authenticationprofile": resourceAuthenticationProfile(),
 			"centrify_domain":                resourceDomain(),
@@ -195,11 +292,17 @@ func Provider() *schema.Provider {
 			"centrify_webapp_generic":        resourceGenericWebApp(),
 			"centrify_federatedgroup":        resourceFederatedGroup(),
 		},
-		ConfigureFunc: providerConfigure,
 	}
+
+	p.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
+		// StopContext is cancelled when Terraform is interrupted, so in-flight API calls are aborted
+		return providerConfigure(d, p.StopContext())
+	}
+
+	return p
 }
 
-func providerConfigure(d *schema.ResourceData) (interface{}, error) {
+func providerConfigure(d *schema.ResourceData, stopCtx context.Context) (interface{}, error) {
 	config := Config{
 		URL:            d.Get("url").(string),
 		AppID:          d.Get("appid").(string),
@@ -208,39 +311,48 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Password:       d.Get("password").(string),
 		Token:          d.Get("token").(string),
 		UseDMC:         d.Get("use_dmc").(bool),
+		AuthType:       d.Get("auth_type").(string),
+		MFATOTPSecret:  d.Get("mfa_totp_secret").(string),
 		LogPath:        d.Get("logpath").(string),
 		SkipCertVerify: d.Get("skip_cert_verify").(bool),
 		LogLevel:       d.Get("log_level").(string),
-	}
-	switch config.LogLevel {
-	case "fatal":
-		logger.SetLevel(logger.LevelFatal)
-	case "error":
-		logger.SetLevel(logger.LevelError)
-	case "info":
-		logger.SetLevel(logger.LevelInfo)
-	case "debug":
-		logger.SetLevel(logger.LevelDebug)
-	}
 
-	logPath = config.LogPath
+		RetryMaxAttempts: d.Get("retry_max_attempts").(int),
+		RetryMinBackoff:  d.Get("retry_min_backoff").(int),
+		RetryMaxBackoff:  d.Get("retry_max_backoff").(int),
 
-	if config.LogPath != "" {
-		logger.SetLogPath(config.LogPath)
-		logger.EnableErrorStackTrace()
+		RateLimit:   d.Get("rate_limit").(float64),
+		RateBurst:   d.Get("rate_burst").(int),
+		MaxInFlight: d.Get("max_in_flight").(int),
+	}
+	if v, ok := d.GetOk("mfa_answers"); ok {
+		config.MFAAnswers = make(map[string]string)
+		for k, answer := range v.(map[string]interface{}) {
+			config.MFAAnswers[k] = answer.(string)
+		}
+	}
+	if v, ok := d.GetOk("mfa_mechanisms"); ok {
+		for _, mech := range v.([]interface{}) {
+			config.MFAMechanisms = append(config.MFAMechanisms, mech.(string))
+		}
 	}
 
-	logger.Infof("Starting provider configuration...")
+	log := config.newLogger()
+	log.Infof("Starting provider configuration...")
 	if err := config.Valid(); err != nil {
 		return nil, err
 	}
 
-	restClient, err := config.getClient()
+	restClient, err := config.getClient(log)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to authenticate to Centrify Platform: %v", err)
 	}
-	logger.Infof("Connected to Centrify Platform %s", config.URL)
+	log.Infof("Connected to Centrify Platform %s", config.URL)
 
-	return restClient, nil
+	return &providerMeta{
+		client: restClient.WithContext(stopCtx),
+		logger: log,
+		config: config,
+	}, nil
 }
@@ -1,9 +1,14 @@
 package centrify
 
 import (
+	"io/ioutil"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
@@ -17,17 +22,124 @@ func init() {
 		"centrify": testAccProvider,
 	}
 }
+
+// testTenant starts fake tenant answering API calls with handlers and returns provider meta that uses it
+func testTenant(t *testing.T, handlers map[string]restapitest.Handler) (*providerMeta, *httptest.Server) {
+	server := restapitest.NewServer(t, handlers)
+	return &providerMeta{client: restapitest.NewClient(server)}, server
+}
+
 func TestProvider(t *testing.T) {
 	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
+
+func TestConfigValid(t *testing.T) {
+	cases := []struct {
+		name   string
+		config Config
+		valid  bool
+	}{
+		{"oauth", Config{URL: "u", AppID: "a", Scope: "s", Username: "c", Password: "p"}, true},
+		{"oauth without secret", Config{URL: "u", AppID: "a", Scope: "s", Username: "c"}, false},
+		{"legacy token", Config{URL: "u", Token: "t"}, true},
+		{"oauth_token without token", Config{URL: "u", AuthType: authTypeOAuthToken}, false},
+		{"legacy dmc", Config{URL: "u", Scope: "s", UseDMC: true}, true},
+		{"dmc without scope", Config{URL: "u", AuthType: authTypeDMC}, false},
+		{"unpw", Config{URL: "u", AuthType: authTypeUnPw, Username: "admin@tenant", Password: "p"}, true},
+		{"unpw without password", Config{URL: "u", AuthType: authTypeUnPw, Username: "admin@tenant"}, false},
+		{"no url", Config{AuthType: authTypeUnPw, Username: "admin@tenant", Password: "p"}, false},
+		{"invalid auth_type", Config{URL: "u", AuthType: "saml"}, false},
+	}
+	for _, tc := range cases {
+		if err := tc.config.Valid(); (err == nil) != tc.valid {
+			t.Errorf("%s: expected valid=%v, got %v", tc.name, tc.valid, err)
+		}
+	}
+}
+
+func TestProviderWithoutAuthType(t *testing.T) {
+	for _, env := range []string{"CENTRIFY_AUTHTYPE", "VAULT_AUTHTYPE"} {
+		if v, ok := os.LookupEnv(env); ok {
+			os.Unsetenv(env)
+			defer os.Setenv(env, v)
+		}
+	}
+	raw := map[string]interface{}{
+		"url":       "https://tenant.my.centrify.net",
+		"appid":     "app",
+		"scope":     "scope",
+		"username":  "client",
+		"password":  "secret",
+		"log_level": "error",
+	}
+	if _, errs := Provider().Validate(terraform.NewResourceConfigRaw(raw)); len(errs) > 0 {
+		t.Errorf("expected provider without auth_type to be valid, got %v", errs)
+	}
+}
+
+func TestProviderAliasesAreIsolated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "centrify-provider")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	configure := func(url, level, logpath string) *providerMeta {
+		p := Provider()
+		raw := map[string]interface{}{
+			"url":       url,
+			"auth_type": authTypeOAuthToken,
+			"token":     "token",
+			"log_level": level,
+			"logpath":   logpath,
+		}
+		if err := p.Configure(terraform.NewResourceConfigRaw(raw)); err != nil {
+			t.Fatalf("configuring provider for %s: %v", url, err)
+		}
+		return p.Meta().(*providerMeta)
+	}
+	prodLog := filepath.Join(dir, "prod.log")
+	stagingLog := filepath.Join(dir, "staging.log")
+	prod := configure("https://prod.my.centrify.net", "debug", prodLog)
+	staging := configure("https://staging.my.centrify.net", "error", stagingLog)
+
+	if prod.client.Service == staging.client.Service {
+		t.Errorf("both providers use %s", prod.client.Service)
+	}
+	if prod.client.Logger != prod.logger || staging.client.Logger != staging.logger {
+		t.Errorf("REST client doesn't use logger of its provider")
+	}
+
+	prod.logger.Debugf("prod message")
+	staging.logger.Debugf("staging debug message")
+	staging.logger.Errorf("staging error message")
+
+	prodContent, _ := ioutil.ReadFile(prodLog)
+	stagingContent, _ := ioutil.ReadFile(stagingLog)
+	if !strings.Contains(string(prodContent), "prod message") || strings.Contains(string(prodContent), "staging") {
+		t.Errorf("unexpected prod log:\n%s", prodContent)
+	}
+	if strings.Contains(string(stagingContent), "staging debug message") || !strings.Contains(string(stagingContent), "staging error message") ||
+		strings.Contains(string(stagingContent), "prod") {
+		t.Errorf("unexpected staging log:\n%s", stagingContent)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 
 	if v := os.Getenv("CENTRIFY_URL"); v == "" {
 		t.Fatal("PAS URL must be set for acceptance tests")
 	}
 
+	if v := os.Getenv("CENTRIFY_AUTHTYPE"); v == authTypeUnPw {
+		if os.Getenv("CENTRIFY_USERNAME") == "" || os.Getenv("CENTRIFY_PASSWORD") == "" {
+			t.Fatal("USERNAME and PASSWORD must be set for acceptance tests")
+		}
+		return
+	}
+
 	if v := os.Getenv("CENTRIFY_SCOPE"); v == "" {
 		t.Fatal("SCOPE must be set for acceptance tests")
 	}
@@ -0,0 +1,117 @@
+package centrify
+
+import (
+	"fmt"
+	"time"
+
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceAccountRotation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountRotationCreate,
+		Read:   resourceAccountRotationRead,
+		Delete: resourceAccountRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the managed account whose password is rotated",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that rotates password again when changed",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"unlock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Whether to unlock account before rotating its password",
+			},
+			"verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether to verify rotated password on the target and fail if it doesn't work",
+			},
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time password was rotated",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Credential health status reported by verification",
+			},
+		},
+	}
+}
+
+func resourceAccountRotationRead(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading account rotation: %s", ResourceIDString(d))
+
+	// Rotation itself can't be read back, only the account it applies to
+	object := vault.NewAccount(client)
+	object.ID = d.Get("account_id").(string)
+	if err := object.Read(); err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
+		return fmt.Errorf(" Error reading account: %v", err)
+	}
+
+	client.Logger.Infof("Completed reading account rotation: %s", object.ID)
+	return nil
+}
+
+func resourceAccountRotationCreate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning account password rotation: %s", ResourceIDString(d))
+
+	object := vault.NewAccount(client)
+	object.ID = d.Get("account_id").(string)
+
+	if d.Get("unlock").(bool) {
+		if err := object.UnlockAccount(); err != nil {
+			return fmt.Errorf(" Error unlocking account: %v", err)
+		}
+	}
+	if err := object.RotatePassword(); err != nil {
+		return fmt.Errorf(" Error rotating account password: %v", err)
+	}
+	rotatedAt := time.Now().UTC()
+	d.SetId(fmt.Sprintf("%s/%d", object.ID, rotatedAt.UnixNano()))
+	d.Set("rotated_at", rotatedAt.Format(time.RFC3339))
+
+	if d.Get("verify").(bool) {
+		health, err := object.VerifyCredential()
+		d.Set("health", health)
+		if err != nil {
+			// Password has been rotated, so resource stays in state as tainted and next apply rotates it again
+			return fmt.Errorf(" Error verifying rotated account password: %v", err)
+		}
+	}
+
+	client.Logger.Infof("Rotation of account password completed: %s", object.ID)
+	return resourceAccountRotationRead(d, m)
+}
+
+func resourceAccountRotationDelete(d *schema.ResourceData, m interface{}) error {
+	// Nothing to undo in tenant, rotated password stays
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,84 @@
+package centrify
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// rotationTenant records account actions and reports given credential health
+func rotationTenant(t *testing.T, health string, calls *[]string) (*providerMeta, *httptest.Server) {
+	action := func(r *restapitest.Request) interface{} {
+		if r.Args["ID"] != "account-1" {
+			t.Errorf("unexpected account %v", r.Args["ID"])
+		}
+		*calls = append(*calls, r.URL.Path)
+		return health
+	}
+	return testTenant(t, map[string]restapitest.Handler{
+		"/ServerManage/GetAllAccountInformation": restapitest.Result(map[string]interface{}{"VaultAccount": map[string]interface{}{"Row": map[string]interface{}{"ID": "account-1"}}}),
+		"/ServerManage/GetAccountChallenges":     restapitest.Result(map[string]interface{}{}),
+		"/ServerManage/UnlockAccount":            action,
+		"/ServerManage/RotatePassword":           action,
+		"/ServerManage/CheckAccountHealth":       action,
+	})
+}
+
+func TestAccountRotation(t *testing.T) {
+	cases := []struct {
+		name   string
+		config map[string]interface{}
+		health string
+		calls  []string
+		err    string
+	}{
+		{
+			"unlock, rotate and verify",
+			map[string]interface{}{"account_id": "account-1", "unlock": true, "triggers": map[string]interface{}{"release": "1.2"}},
+			"OK",
+			[]string{"/ServerManage/UnlockAccount", "/ServerManage/RotatePassword", "/ServerManage/CheckAccountHealth"},
+			"",
+		},
+		{
+			"without verification",
+			map[string]interface{}{"account_id": "account-1", "verify": false},
+			"OK",
+			[]string{"/ServerManage/RotatePassword"},
+			"",
+		},
+		{
+			"failed verification",
+			map[string]interface{}{"account_id": "account-1"},
+			"BadCredentials",
+			[]string{"/ServerManage/RotatePassword", "/ServerManage/CheckAccountHealth"},
+			"Credential of account account-1 failed verification: BadCredentials",
+		},
+	}
+	for _, tc := range cases {
+		var calls []string
+		meta, server := rotationTenant(t, tc.health, &calls)
+
+		d := schema.TestResourceDataRaw(t, resourceAccountRotation().Schema, tc.config)
+		err := resourceAccountRotationCreate(d, meta)
+		server.Close()
+		if tc.err == "" && err != nil {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		} else if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+			t.Errorf("%s: expected error %q, got %v", tc.name, tc.err, err)
+		}
+		if !reflect.DeepEqual(calls, tc.calls) {
+			t.Errorf("%s: expected calls %v, got %v", tc.name, tc.calls, calls)
+		}
+		// Rotation happened even if verification failed, so it must be kept in state
+		if !strings.HasPrefix(d.Id(), "account-1/") || d.Get("rotated_at").(string) == "" {
+			t.Errorf("%s: expected rotation in state, got id %q", tc.name, d.Id())
+		}
+		if health := d.Get("health").(string); tc.config["verify"] == nil && health != tc.health {
+			t.Errorf("%s: expected health %s, got %s", tc.name, tc.health, health)
+		}
+	}
+}
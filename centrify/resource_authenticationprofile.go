@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -89,27 +87,29 @@ func getAuthenticationProfileSchema() map[string]*schema.Schema {
 }
 
 func resourceAuthenticationProfileExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking authentication profile exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking authentication profile exist: %s", ResourceIDString(d))
 
 	object := vault.NewAuthenticationProfile(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Authentication profile exists in tenant: %s", object.ID)
+	client.Logger.Infof("Authentication profile exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceAuthenticationProfileRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading authentication profile: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading authentication profile: %s", ResourceIDString(d))
 
 	// Create a authentication profile object and populate ID attribute
 	object := vault.NewAuthenticationProfile(client)
@@ -119,16 +119,18 @@ func resourceAuthenticationProfileRead(d *schema.ResourceData, m interface{}) er
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading authentication profile: %v", err)
 	}
-	//logger.Debugf("Authentication profile from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceAuthenticationProfileRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceAuthenticationProfileRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		if k == "additional_data" {
 			d.Set(k, flattenAdditionalData(object.AdditionalData))
@@ -137,13 +139,14 @@ func resourceAuthenticationProfileRead(d *schema.ResourceData, m interface{}) er
 		}
 	}
 
-	logger.Infof("Completed reading authentication profile: %s", object.Name)
+	client.Logger.Infof("Completed reading authentication profile: %s", object.Name)
 	return nil
 }
 
 func resourceAuthenticationProfileDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of authentication profile: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of authentication profile: %s", ResourceIDString(d))
 
 	object := vault.NewAuthenticationProfile(client)
 	object.ID = d.Id()
@@ -159,14 +162,14 @@ func resourceAuthenticationProfileDelete(d *schema.ResourceData, m interface{})
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of authentication profile completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of authentication profile completed: %s", ResourceIDString(d))
 	return nil
 }
 
 func resourceAuthenticationProfileCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning authentication profile creation: %s", ResourceIDString(d))
-
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning authentication profile creation: %s", ResourceIDString(d))
 
 	// Create a authentication profile object and populate all attributes
 	object := vault.NewAuthenticationProfile(client)
@@ -186,14 +189,15 @@ func resourceAuthenticationProfileCreate(d *schema.ResourceData, m interface{})
 	object.ID = id
 
 	// Creation completed
-	logger.Infof("Creation of authentication profile completed: %s", object.Name)
+	client.Logger.Infof("Creation of authentication profile completed: %s", object.Name)
 	return resourceAuthenticationProfileRead(d, m)
 }
 
 func resourceAuthenticationProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning authentication profile update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning authentication profile update: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewAuthenticationProfile(client)
 
 	object.ID = d.Id()
@@ -206,10 +210,10 @@ func resourceAuthenticationProfileUpdate(d *schema.ResourceData, m interface{})
 		if err != nil || !resp.Success {
 			return fmt.Errorf("error updating authentication profile attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %+v", object)
+		client.Logger.Debugf("Updated attributes to: %+v", object)
 	}
 
-	logger.Infof("Updating of authentication profile completed: %s", object.Name)
+	client.Logger.Infof("Updating of authentication profile completed: %s", object.Name)
 	return resourceAuthenticationProfileRead(d, m)
 }
 
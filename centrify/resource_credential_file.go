@@ -0,0 +1,250 @@
+package centrify
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// cleanupTimeout limits how long Cleanup waits for check-ins, which has to fit in the time plugin client gives the provider to exit
+const cleanupTimeout = 1500 * time.Millisecond
+
+// credentialFiles keeps credential files written by this provider process with their password leases, if any,
+// so that they are removed and checked in when Terraform completes
+var credentialFiles = struct {
+	sync.Mutex
+	files map[string]*vault.PasswordLease
+}{files: make(map[string]*vault.PasswordLease)}
+
+func resourceCredentialFile() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCredentialFileCreate,
+		Read:   resourceCredentialFileRead,
+		Delete: resourceCredentialFileDelete,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"account_id", "secret_id"},
+				Description:  "ID of the account whose password or SSH key is retrieved",
+			},
+			"secret_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the text secret that is retrieved",
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Path of the file credential is written to. A temporary file is used if it isn't set",
+			},
+			"key_pair_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "PrivateKey",
+				Description: "Which key to retrieve if account credential is SSH key",
+				ValidateFunc: validation.StringInSlice([]string{
+					"PublicKey",
+					"PrivateKey",
+					"PPK",
+				}, false),
+			},
+			"passphrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Passphrase to use for encrypting the PrivateKey",
+			},
+			"coid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checkout ID of account password",
+			},
+			"expiry": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time checkout of account password expires unless it is extended",
+			},
+		},
+	}
+}
+
+func resourceCredentialFileRead(d *schema.ResourceData, m interface{}) error {
+	// Credential file only lives as long as the Terraform run that created it
+	if _, err := os.Stat(d.Id()); os.IsNotExist(err) {
+		m.(*providerMeta).client.Logger.Infof("Credential file %s no longer exists, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	return nil
+}
+
+func resourceCredentialFileCreate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning credential retrieval: %s", ResourceIDString(d))
+
+	// Checkout is bound to provider context, which is cancelled when Terraform is interrupted
+	stopCtx := m.(*providerMeta).client.Context()
+	var content string
+	var lease *vault.PasswordLease
+	if v, ok := d.GetOk("account_id"); ok {
+		account := vault.NewAccount(client)
+		account.ID = v.(string)
+		if err := account.Read(); err != nil {
+			return fmt.Errorf(" Error reading account: %v", err)
+		}
+		switch account.CredentialType {
+		case "Password":
+			var err error
+			lease, err = account.CheckoutPasswordLease(stopCtx, true)
+			if err != nil {
+				return fmt.Errorf(" Error checking out account password: %v", err)
+			}
+			content = lease.Password
+			d.Set("coid", lease.COID)
+			d.Set("expiry", lease.Expiry().Format(time.RFC3339))
+		case "SshKey":
+			var err error
+			content, err = account.RetrieveSSHKey(d.Get("key_pair_type").(string), d.Get("passphrase").(string))
+			if err != nil {
+				return fmt.Errorf(" Error retrieving account SSH key: %v", err)
+			}
+		default:
+			return fmt.Errorf(" Credential type %s of account %s can't be written to file", account.CredentialType, account.ID)
+		}
+	} else {
+		secret := vault.NewSecret(client)
+		secret.ID = d.Get("secret_id").(string)
+		var err error
+		content, err = secret.CheckoutSecret()
+		if err != nil {
+			return fmt.Errorf(" Error retrieving secret: %v", err)
+		}
+	}
+
+	path, err := writeCredentialFile(d.Get("path").(string), content)
+	if err != nil {
+		if lease != nil {
+			lease.Close()
+		}
+		return fmt.Errorf(" Error writing credential file: %v", err)
+	}
+	registerCredentialFile(stopCtx, path, lease)
+
+	d.SetId(path)
+	d.Set("path", path)
+	client.Logger.Infof("Credential written to %s", path)
+	return resourceCredentialFileRead(d, m)
+}
+
+func resourceCredentialFileDelete(d *schema.ResourceData, m interface{}) error {
+	if err := removeCredentialFile(d.Id()); err != nil {
+		return fmt.Errorf(" Error removing credential file: %v", err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// writeCredentialFile writes content to path, or to a temporary file if path is empty, readable only by the owner
+func writeCredentialFile(path string, content string) (string, error) {
+	var f *os.File
+	var err error
+	if path == "" {
+		f, err = ioutil.TempFile("", "centrify-credential-")
+	} else {
+		f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	}
+	if err != nil {
+		return "", err
+	}
+	// Existing file may have been readable by others
+	if err = f.Chmod(0600); err == nil {
+		_, err = f.WriteString(content)
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// registerCredentialFile makes sure file is removed when Terraform completes or is interrupted
+func registerCredentialFile(ctx context.Context, path string, lease *vault.PasswordLease) {
+	credentialFiles.Lock()
+	credentialFiles.files[path] = lease
+	credentialFiles.Unlock()
+
+	if ctx.Done() != nil {
+		go func() {
+			<-ctx.Done()
+			// File may have been removed and written again by another resource in the meantime
+			credentialFiles.Lock()
+			current, ok := credentialFiles.files[path]
+			credentialFiles.Unlock()
+			if ok && current == lease {
+				removeCredentialFile(path)
+			}
+		}()
+	}
+}
+
+// removeCredentialFile removes credential file and checks in its password
+func removeCredentialFile(path string) error {
+	credentialFiles.Lock()
+	lease := credentialFiles.files[path]
+	delete(credentialFiles.files, path)
+	credentialFiles.Unlock()
+
+	var result error
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		result = err
+	}
+	if lease != nil {
+		if err := lease.Close(); err != nil && result == nil {
+			result = err
+		}
+	}
+	return result
+}
+
+// Cleanup removes credential files and checks in passwords that are left by this provider process.
+// It is meant to be called when provider exits. Plugin client kills the provider shortly after asking it to exit,
+// so cleanup is best effort: files are removed first and check-ins are abandoned after cleanupTimeout
+func Cleanup() error {
+	credentialFiles.Lock()
+	files := credentialFiles.files
+	credentialFiles.files = make(map[string]*vault.PasswordLease)
+	credentialFiles.Unlock()
+
+	var result error
+	for path := range files {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) && result == nil {
+			result = err
+		}
+	}
+	// Leases of credential files are open leases as well, so they are checked in together with data source leases
+	ctx, cancel := context.WithTimeout(context.Background(), cleanupTimeout)
+	defer cancel()
+	if err := vault.CloseLeasesWithContext(ctx); err != nil && result == nil {
+		result = err
+	}
+	return result
+}
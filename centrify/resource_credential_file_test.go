@@ -0,0 +1,106 @@
+package centrify
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// credentialTenant serves a password account and a text secret, and counts check-ins and extensions
+func credentialTenant(t *testing.T, checkins *int, extends *int) (*providerMeta, *httptest.Server) {
+	return testTenant(t, map[string]restapitest.Handler{
+		"/ServerManage/GetAllAccountInformation": restapitest.Result(map[string]interface{}{"VaultAccount": map[string]interface{}{"Row": map[string]interface{}{
+			"ID": "account-1", "User": "root", "CredentialType": "Password", "DefaultCheckoutTime": 15,
+		}}}),
+		"/ServerManage/GetAccountChallenges": restapitest.Result(map[string]interface{}{}),
+		"/ServerManage/CheckoutPassword":     restapitest.Result(map[string]interface{}{"Password": "account-password", "COID": "coid-1"}),
+		"/ServerManage/CheckinPassword": func(r *restapitest.Request) interface{} {
+			*checkins++
+			return true
+		},
+		"/ServerManage/ExtendCheckout": func(r *restapitest.Request) interface{} {
+			*extends++
+			return true
+		},
+		"/ServerManage/RetrieveSecretContents": restapitest.Result(map[string]interface{}{"SecretText": "secret-text"}),
+	})
+}
+
+func TestCredentialFile(t *testing.T) {
+	var checkins, extends int
+	meta, server := credentialTenant(t, &checkins, &extends)
+	defer server.Close()
+	dir, err := ioutil.TempDir("", "credential-file-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cases := []struct {
+		name    string
+		config  map[string]interface{}
+		content string
+		coid    string
+	}{
+		{"account password", map[string]interface{}{"account_id": "account-1", "path": filepath.Join(dir, "password")}, "account-password", "coid-1"},
+		{"secret in temporary file", map[string]interface{}{"secret_id": "secret-1"}, "secret-text", ""},
+	}
+	for _, tc := range cases {
+		d := schema.TestResourceDataRaw(t, resourceCredentialFile().Schema, tc.config)
+		if err := resourceCredentialFileCreate(d, meta); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		path := d.Get("path").(string)
+		content, err := ioutil.ReadFile(path)
+		if err != nil || string(content) != tc.content {
+			t.Errorf("%s: expected file content %q, got %q %v", tc.name, tc.content, content, err)
+		}
+		if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+			t.Errorf("%s: expected file mode 0600, got %v", tc.name, info.Mode())
+		}
+		if d.Get("coid").(string) != tc.coid {
+			t.Errorf("%s: expected coid %q, got %q", tc.name, tc.coid, d.Get("coid"))
+		}
+		for k, v := range d.State().Attributes {
+			if strings.Contains(v, tc.content) {
+				t.Errorf("%s: credential found in state attribute %s", tc.name, k)
+			}
+		}
+	}
+	if checkins != 0 {
+		t.Errorf("expected password to stay checked out during the run")
+	}
+
+	// Checkout is extended after Create has returned and its client is done
+	credentialFiles.Lock()
+	lease := credentialFiles.files[filepath.Join(dir, "password")]
+	credentialFiles.Unlock()
+	if err := lease.Extend(); err != nil {
+		t.Errorf("expected checkout to be extended after create, got %v", err)
+	}
+	if extends != 1 {
+		t.Errorf("expected one extension, got %d", extends)
+	}
+
+	// Files and checkouts don't outlive the run, so the resource is gone on next refresh
+	if err := Cleanup(); err != nil {
+		t.Fatal(err)
+	}
+	if checkins != 1 {
+		t.Errorf("expected password to be checked in once, got %d", checkins)
+	}
+	d := schema.TestResourceDataRaw(t, resourceCredentialFile().Schema, map[string]interface{}{"secret_id": "secret-1"})
+	d.SetId(filepath.Join(dir, "password"))
+	if err := resourceCredentialFileRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() != "" {
+		t.Errorf("expected resource to be removed after its file is gone")
+	}
+}
@@ -2,12 +2,10 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/desktopapp/applicationtemplate"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/desktopapp/cmdparamtype"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/desktopapp/logincredential"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -178,27 +176,29 @@ func getDesktopAppSchema() map[string]*schema.Schema {
 }
 
 func resourceDesktopAppExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking DesktopApp exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking DesktopApp exist: %s", ResourceIDString(d))
 
 	object := vault.NewDesktopApp(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("DesktopApp exists in tenant: %s", object.ID)
+	client.Logger.Infof("DesktopApp exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceDesktopAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading DesktopApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading DesktopApp: %s", ResourceIDString(d))
 
 	// Create a NewDesktopApp object and populate ID attribute
 	object := vault.NewDesktopApp(client)
@@ -208,15 +208,17 @@ func resourceDesktopAppRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading DesktopApp: %v", err)
 	}
-	//logger.Debugf("DesktopApp from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceDesktopAppRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceDesktopAppRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -231,25 +233,25 @@ func resourceDesktopAppRead(d *schema.ResourceData, m interface{}) error {
 				d.Set(k, v)
 			}
 		case "command_parameter":
-			logger.Debugf("cmd pars: %+v", v)
+			client.Logger.Debugf("cmd pars: %+v", v)
 			d.Set(k, v)
 		default:
 			d.Set(k, v)
 		}
 	}
 
-	logger.Infof("Completed reading DesktopApp: %s", object.Name)
+	client.Logger.Infof("Completed reading DesktopApp: %s", object.Name)
 	return nil
 }
 
 func resourceDesktopAppCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning DesktopApp creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning DesktopApp creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a DesktopApp object
 	object := vault.NewDesktopApp(client)
 	object.TemplateName = d.Get("template_name").(string)
@@ -298,17 +300,18 @@ func resourceDesktopAppCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of DesktopApp completed: %s", object.Name)
+	client.Logger.Infof("Creation of DesktopApp completed: %s", object.Name)
 	return resourceDesktopAppRead(d, m)
 }
 
 func resourceDesktopAppUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning DesktopApp update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning DesktopApp update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewDesktopApp(client)
 	object.ID = d.Id()
 	err := getUpateGetDesktopAppData(d, object)
@@ -323,7 +326,7 @@ func resourceDesktopAppUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating DesktopApp attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("sets") {
@@ -382,13 +385,14 @@ func resourceDesktopAppUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of DesktopApp completed: %s", object.Name)
+	client.Logger.Infof("Updating of DesktopApp completed: %s", object.Name)
 	return resourceDesktopAppRead(d, m)
 }
 
 func resourceDesktopAppDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of DesktopApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of DesktopApp: %s", ResourceIDString(d))
 
 	object := vault.NewDesktopApp(client)
 	object.ID = d.Id()
@@ -404,7 +408,7 @@ func resourceDesktopAppDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of DesktopApp completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of DesktopApp completed: %s", ResourceIDString(d))
 	return nil
 }
 
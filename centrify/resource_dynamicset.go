@@ -0,0 +1,336 @@
+package centrify
+
+import (
+	"fmt"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func resourceDynamicSet() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDynamicSetCreate,
+		Read:   resourceDynamicSetRead,
+		Update: resourceDynamicSetUpdate,
+		Delete: resourceDynamicSetDelete,
+		Exists: resourceDynamicSetExists,
+		Importer: &schema.ResourceImporter{
+			State: importStateByName("set", "<type>/<name>", lookupDynamicSetID),
+		},
+		CustomizeDiff: customizeDynamicSetDiff,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the dynamic set",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the dynamic set",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Type of set. Valid values are: Server, VaultAccount, VaultDatabase, VaultDomain, DataVault, SshKeys, Subscriptions, Application, ResourceProfiles, CloudProviders",
+				ValidateFunc: validation.StringInSlice([]string{
+					settype.System.String(),
+					settype.Account.String(),
+					settype.Database.String(),
+					settype.Domain.String(),
+					settype.Secret.String(),
+					settype.SSHKey.String(),
+					settype.Service.String(),
+					settype.Application.String(),
+					settype.ResourceProfile.String(),
+					settype.CloudProvider.String(),
+				}, false),
+			},
+			"subtype": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SubObjectType for application. Valid values are: Web and Desktop",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Web",
+					"Desktop",
+				}, false),
+			},
+			"query": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "RedRock SELECT statement on table of set type that returns members of the set",
+				ValidateFunc: validateDynamicSetQuery,
+			},
+			"permission":        getPermissionSchema(),
+			"member_permission": getPermissionSchema(),
+		},
+	}
+}
+
+// validateDynamicSetQuery checks query structure. Table is checked against set type by customizeDynamicSetDiff
+func validateDynamicSetQuery(v interface{}, k string) (ws []string, errors []error) {
+	if err := vault.ValidateDynamicSetQuery("", v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %v", k, err))
+	}
+	return
+}
+
+// customizeDynamicSetDiff checks at plan time that query selects from table of set type
+func customizeDynamicSetDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("query") {
+		return nil
+	}
+	return vault.ValidateDynamicSetQuery(d.Get("type").(string), d.Get("query").(string))
+}
+
+func resourceDynamicSetExists(d *schema.ResourceData, m interface{}) (bool, error) {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Dynamic Set exist: %s", ResourceIDString(d))
+
+	object := vault.NewDynamicSet(client)
+	object.ID = d.Id()
+	err := object.Read()
+
+	if err != nil {
+		if restapi.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	client.Logger.Infof("Dynamic Set exists in tenant: %s", object.ID)
+	return true, nil
+}
+
+func resourceDynamicSetRead(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Dynamic Set: %s", ResourceIDString(d))
+
+	// Create a Dynamic Set object and populate ID attribute
+	object := vault.NewDynamicSet(client)
+	object.ID = d.Id()
+	err := object.Read()
+
+	// If the resource does not exist, inform Terraform. We want to immediately
+	// return here to prevent further processing.
+	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
+		d.SetId("")
+		return fmt.Errorf(" Error reading Dynamic Set: %v", err)
+	}
+
+	schemamap, err := vault.GenerateSchemaMap(object)
+	if err != nil {
+		return err
+	}
+	client.Logger.Debugf("Generated Map for resourceDynamicSetRead(): %+v", schemamap)
+	for k, v := range schemamap {
+		if k != "collection_type" {
+			d.Set(k, v)
+		}
+	}
+
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading Dynamic Set: %s", object.Name)
+	return nil
+}
+
+func resourceDynamicSetCreate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Dynamic Set creation: %s", ResourceIDString(d))
+
+	// Enable partial state mode
+	d.Partial(true)
+
+	// Create a dynamic set object and populate all attributes
+	object, err := vault.NewDynamicSetWithType(client, d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+
+	err = createUpateGetDynamicSetData(d, object)
+	if err != nil {
+		return err
+	}
+
+	resp, err := object.Create()
+	if err != nil {
+		return fmt.Errorf(" Error creating Dynamic Set: %v", err)
+	}
+
+	id := resp.Result
+	if id == "" {
+		return fmt.Errorf(" The Dynamic Set ID is not set")
+	}
+	d.SetId(id)
+	// Need to populate ID attribute for subsequence processes
+	object.ID = id
+
+	// Handle Set permissions
+	if _, ok := d.GetOk("permission"); ok {
+		_, err = object.SetPermissions(false)
+		if err != nil {
+			return fmt.Errorf(" Error setting Dynamic Set permissions: %v", err)
+		}
+	}
+
+	// Handle Set member permissions
+	if _, ok := d.GetOk("member_permission"); ok {
+		_, err = object.SetMemberPermissions(false)
+		if err != nil {
+			return fmt.Errorf(" Error setting Dynamic Set member permissions: %v", err)
+		}
+	}
+
+	// Creation completed
+	d.Partial(false)
+	client.Logger.Infof("Creation of Dynamic Set completed: %s", object.Name)
+	return resourceDynamicSetRead(d, m)
+}
+
+func resourceDynamicSetUpdate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Dynamic Set update: %s", ResourceIDString(d))
+
+	// Enable partial state mode
+	d.Partial(true)
+
+	object, err := vault.NewDynamicSetWithType(client, d.Get("type").(string))
+	if err != nil {
+		return err
+	}
+	object.ID = d.Id()
+	err = createUpateGetDynamicSetData(d, object)
+	if err != nil {
+		return err
+	}
+
+	// Deal with normal attribute changes first
+	if d.HasChanges("name", "description", "subtype", "query") {
+		resp, err := object.Update()
+		if err != nil || !resp.Success {
+			return fmt.Errorf(" Error updating Dynamic Set attribute: %v", err)
+		}
+	}
+
+	// Deal with permission changes
+	if d.HasChange("permission") {
+		old, new := d.GetChange("permission")
+		// We don't want to care the details of changes
+		// So, let's first remove the old permissions
+		var err error
+		if old != nil {
+			// do not validate old values
+			object.Permissions, err = expandPermissions(old, object.ValidPermissions, false)
+			if err != nil {
+				return err
+			}
+			_, err = object.SetPermissions(true)
+			if err != nil {
+				return fmt.Errorf(" Error removing Dynamic Set permissions: %v", err)
+			}
+		}
+
+		if new != nil {
+			object.Permissions, err = expandPermissions(new, object.ValidPermissions, true)
+			if err != nil {
+				return err
+			}
+			_, err = object.SetPermissions(false)
+			if err != nil {
+				return fmt.Errorf(" Error adding Dynamic Set permissions: %v", err)
+			}
+		}
+	}
+
+	// Deal with member permission changes
+	if d.HasChange("member_permission") {
+		old, new := d.GetChange("member_permission")
+		var err error
+		if old != nil {
+			object.MemberPermissions, err = expandPermissions(old, object.ValidMemberPermissions, false)
+			if err != nil {
+				return err
+			}
+			_, err = object.SetMemberPermissions(true)
+			if err != nil {
+				return fmt.Errorf(" Error removing Dynamic Set member permissions: %v", err)
+			}
+		}
+
+		if new != nil {
+			object.MemberPermissions, err = expandPermissions(new, object.ValidMemberPermissions, true)
+			if err != nil {
+				return err
+			}
+			_, err = object.SetMemberPermissions(false)
+			if err != nil {
+				return fmt.Errorf(" Error adding Dynamic Set member permissions: %v", err)
+			}
+		}
+	}
+
+	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
+	d.Partial(false)
+	client.Logger.Infof("Updating of Dynamic Set completed: %s", object.Name)
+	return resourceDynamicSetRead(d, m)
+}
+
+func resourceDynamicSetDelete(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Dynamic Set: %s", ResourceIDString(d))
+
+	object := vault.NewDynamicSet(client)
+	object.ID = d.Id()
+	resp, err := object.Delete()
+	if err != nil {
+		return fmt.Errorf(" Error deleting Dynamic Set: %v", err)
+	}
+
+	if resp.Success {
+		d.SetId("")
+	}
+
+	client.Logger.Infof("Deletion of Dynamic Set completed: %s", ResourceIDString(d))
+	return nil
+}
+
+func createUpateGetDynamicSetData(d *schema.ResourceData, object *vault.DynamicSet) error {
+	object.Name = d.Get("name").(string)
+	object.Description = d.Get("description").(string)
+	object.ObjectType = d.Get("type").(string)
+	object.SubObjectType = d.Get("subtype").(string)
+	object.SQL = d.Get("query").(string)
+
+	if v, ok := d.GetOk("permission"); ok {
+		var err error
+		object.Permissions, err = expandPermissions(v, object.ValidPermissions, true)
+		if err != nil {
+			return err
+		}
+	}
+	if v, ok := d.GetOk("member_permission"); ok {
+		var err error
+		object.MemberPermissions, err = expandPermissions(v, object.ValidMemberPermissions, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
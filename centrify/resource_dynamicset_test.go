@@ -0,0 +1,41 @@
+package centrify
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestDynamicSetQueryPlanValidation(t *testing.T) {
+	cases := []struct {
+		name  string
+		query string
+		valid bool
+	}{
+		{"matching table", "SELECT ID FROM Server WHERE Name LIKE 'web%'", true},
+		{"bracketed table", "select ID from [server]", true},
+		{"other table", "SELECT ID FROM VaultAccount", false},
+		{"not select", "DELETE FROM Server", false},
+		{"several statements", "SELECT ID FROM Server; SELECT ID FROM Role", false},
+		{"comment", "SELECT ID FROM Server -- all", false},
+	}
+	for _, tc := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":  "Web Servers",
+			"type":  "Server",
+			"query": tc.query,
+		})
+		// Query structure is checked by validation and its table against type by plan
+		var err error
+		if _, errs := resourceDynamicSet().Validate(config); len(errs) > 0 {
+			err = errs[0]
+		} else {
+			_, err = resourceDynamicSet().Diff(nil, config, nil)
+		}
+		if tc.valid && err != nil {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		} else if !tc.valid && err == nil {
+			t.Errorf("%s: expected error", tc.name)
+		}
+	}
+}
@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -54,27 +52,29 @@ func getFederatedGroupSchema() map[string]*schema.Schema {
 }
 
 func resourceFederatedGroupExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking federated group exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking federated group exist: %s", ResourceIDString(d))
 
 	object := vault.NewFederatedGroup(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Federated group exists in tenant: %s", object.ID)
+	client.Logger.Infof("Federated group exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceFederatedGroupRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading federated group: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading federated group: %s", ResourceIDString(d))
 
 	// Create a role object and populate ID attribute
 	object := vault.NewFederatedGroup(client)
@@ -84,28 +84,31 @@ func resourceFederatedGroupRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading federated group: %v", err)
 	}
-	logger.Debugf("Federated group from tenant: %v", object)
+	client.Logger.Debugf("Federated group from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceFederatedGroupRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceFederatedGroupRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading federated group: %s", object.Name)
+	client.Logger.Infof("Completed reading federated group: %s", object.Name)
 	return nil
 }
 
 func resourceFederatedGroupCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning federated group creation: %s", ResourceIDString(d))
-
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning federated group creation: %s", ResourceIDString(d))
 
 	// Create a role object and populate all attributes
 	object := vault.NewFederatedGroup(client)
@@ -124,12 +127,12 @@ func resourceFederatedGroupCreate(d *schema.ResourceData, m interface{}) error {
 	d.SetId(id)
 	object.ID = id
 
-	logger.Infof("Creation of federated group completed: %s", object.Name)
+	client.Logger.Infof("Creation of federated group completed: %s", object.Name)
 	return resourceFederatedGroupRead(d, m)
 }
 
 func resourceFederatedGroupDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Deletion of federated group isn't supported. Update terraform state only but not upstream application")
+	getLogger(m).Infof("Deletion of federated group isn't supported. Update terraform state only but not upstream application")
 	d.SetId("")
 
 	return nil
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -72,8 +70,9 @@ func getGroupMappingSchema() map[string]*schema.Schema {
 }
 
 func resourceGroupMappingRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading global group mappings: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading global group mappings: %s", ResourceIDString(d))
 
 	object := vault.NewGroupMappings(client)
 	err := object.Read()
@@ -81,16 +80,18 @@ func resourceGroupMappingRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading global group mappings: %v", err)
 	}
-	//logger.Debugf("Global group mapping from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceGroupMappingRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceGroupMappingRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "attribute_group":
@@ -116,16 +117,17 @@ func resourceGroupMappingRead(d *schema.ResourceData, m interface{}) error {
 
 	//d.Set("mapping", object.Mappings)
 
-	logger.Infof("Completed reading global group mappings")
+	client.Logger.Infof("Completed reading global group mappings")
 	return nil
 }
 
 func resourceGroupMappingCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning global group mappings creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning global group mappings creation: %s", ResourceIDString(d))
 
 	d.SetId("centrifyvault_global_group_mappings")
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewGroupMappings(client)
 
 	createUpateGroupMappingsData(d, object)
@@ -141,16 +143,17 @@ func resourceGroupMappingCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// Creation completed
-	logger.Infof("Creation of global group mappings completed: %s", d.Id())
+	client.Logger.Infof("Creation of global group mappings completed: %s", d.Id())
 	return resourceGroupMappingRead(d, m)
 }
 
 func resourceGroupMappingUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning global group mappings update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning global group mappings update: %s", ResourceIDString(d))
 
 	d.SetId("centrifyvault_global_group_mappings")
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewGroupMappings(client)
 
 	createUpateGroupMappingsData(d, object)
@@ -182,9 +185,10 @@ func resourceGroupMappingUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceGroupMappingDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of global group mappings: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of global group mappings: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewGroupMappings(client)
 	// We need to fill the mappings so that they can be deleted one by one
 	createUpateGroupMappingsData(d, object)
@@ -201,7 +205,7 @@ func resourceGroupMappingDelete(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId("")
 
-	logger.Infof("Deletion of global group mappings completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of global group mappings completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -223,8 +227,6 @@ func expandGroupMappings(v interface{}) []vault.GroupMapping {
 		mapping.GroupName = p.(map[string]interface{})["group_name"].(string)
 		mappings = append(mappings, mapping)
 	}
-	logger.Debugf("Group mappings: %+v", mappings)
-
 	return mappings
 }
 
@@ -237,7 +239,5 @@ func expandAttributeGroup(v interface{}) []vault.GroupMapping {
 		mapping.GroupName = v.(string)
 		mappings = append(mappings, mapping)
 	}
-	logger.Debugf("Attribute group mappings: %+v", mappings)
-
 	return mappings
 }
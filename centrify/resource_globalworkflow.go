@@ -4,9 +4,7 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/workflowtype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -75,8 +73,9 @@ func getGlobalWorkflowSchema() map[string]*schema.Schema {
 }
 
 func resourceGlobalWorkflowRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading global workflow: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading global workflow: %s", ResourceIDString(d))
 
 	object, err := vault.NewGlobalWorkflow(client, d.Get("type").(string))
 	if err != nil {
@@ -88,6 +87,9 @@ func resourceGlobalWorkflowRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading global workflow %v", err)
 	}
@@ -96,19 +98,20 @@ func resourceGlobalWorkflowRead(d *schema.ResourceData, m interface{}) error {
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceGlobalWorkflowRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceGlobalWorkflowRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading global workflow: %s", object.Type)
+	client.Logger.Infof("Completed reading global workflow: %s", object.Type)
 	return nil
 }
 
 func resourceGlobalWorkflowCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning global workflow creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning global workflow creation: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object, err := vault.NewGlobalWorkflow(client, d.Get("type").(string))
 	if err != nil {
 		return err
@@ -126,14 +129,15 @@ func resourceGlobalWorkflowCreate(d *schema.ResourceData, m interface{}) error {
 	object.ID = id
 
 	// Update completed
-	logger.Infof("Creation of global workflow completed: %s", d.Id())
+	client.Logger.Infof("Creation of global workflow completed: %s", d.Id())
 	return resourceGlobalWorkflowRead(d, m)
 }
 
 func resourceGlobalWorkflowUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning global workflow update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning global workflow update: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object, err := vault.NewGlobalWorkflow(client, d.Get("type").(string))
 	if err != nil {
 		return err
@@ -149,13 +153,14 @@ func resourceGlobalWorkflowUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Updating of global workflow completed: %s", object.Type)
+	client.Logger.Infof("Updating of global workflow completed: %s", object.Type)
 	return resourceGlobalWorkflowRead(d, m)
 }
 
 func resourceGlobalWorkflowDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning disabling of global workflow: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning disabling of global workflow: %s", ResourceIDString(d))
 
 	object, err := vault.NewGlobalWorkflow(client, d.Get("type").(string))
 	if err != nil {
@@ -169,7 +174,7 @@ func resourceGlobalWorkflowDelete(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId("")
 
-	logger.Infof("Disabling of global workflow completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Disabling of global workflow completed: %s", ResourceIDString(d))
 	return nil
 }
 
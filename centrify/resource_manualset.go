@@ -2,10 +2,8 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -20,7 +18,7 @@ func resourceManualSet_deprecated() *schema.Resource {
 		Delete: resourceManualSetDelete,
 		Exists: resourceManualSetExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("set", "<type>/<name>", lookupManualSetID),
 		},
 
 		Schema:             getManualSetSchema(),
@@ -36,7 +34,7 @@ func resourceManualSet() *schema.Resource {
 		Delete: resourceManualSetDelete,
 		Exists: resourceManualSetExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("set", "<type>/<name>", lookupManualSetID),
 		},
 
 		Schema: getManualSetSchema(),
@@ -90,27 +88,29 @@ func getManualSetSchema() map[string]*schema.Schema {
 }
 
 func resourceManualSetExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Manual Set exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Manual Set exist: %s", ResourceIDString(d))
 
 	object := vault.NewManualSet(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Manual Set exists in tenant: %s", object.ID)
+	client.Logger.Infof("Manual Set exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceManualSetRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Manual Set: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Manual Set: %s", ResourceIDString(d))
 
 	// Create a Manual Set object and populate ID attribute
 	object := vault.NewManualSet(client)
@@ -120,32 +120,38 @@ func resourceManualSetRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading Manual Set: %v", err)
 	}
-	//logger.Debugf("Manual Set from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceManualSetRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceManualSetRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading Manual Set: %s", object.Name)
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading Manual Set: %s", object.Name)
 	return nil
 }
 
 func resourceManualSetCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Manual Set creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Manual Set creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a manual set object and populate all attributes
 	object, err := vault.NewManualSetWithType(client, d.Get("type").(string))
 	if err != nil {
@@ -189,17 +195,18 @@ func resourceManualSetCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of Manual Set completed: %s", object.Name)
+	client.Logger.Infof("Creation of Manual Set completed: %s", object.Name)
 	return resourceManualSetRead(d, m)
 }
 
 func resourceManualSetUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Manual Set update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Manual Set update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object, err := vault.NewManualSetWithType(client, d.Get("type").(string))
 	if err != nil {
 		return err
@@ -217,7 +224,6 @@ func resourceManualSetUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating Manual Set attribute: %v", err)
 		}
-		//logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	// Deal with permission changes
@@ -282,13 +288,14 @@ func resourceManualSetUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of Manual Set completed: %s", object.Name)
+	client.Logger.Infof("Updating of Manual Set completed: %s", object.Name)
 	return resourceManualSetRead(d, m)
 }
 
 func resourceManualSetDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Manual Set: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Manual Set: %s", ResourceIDString(d))
 
 	object := vault.NewManualSet(client)
 	object.ID = d.Id()
@@ -304,7 +311,7 @@ func resourceManualSetDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Manual Set completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Manual Set completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -93,27 +91,29 @@ func getMultiplexedAccountSchema() map[string]*schema.Schema {
 }
 
 func resourceMultiplexedAccountExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking multiplexed account exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking multiplexed account exist: %s", ResourceIDString(d))
 
 	object := vault.NewMultiplexedAccount(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Multiplexed account exists in tenant: %s", object.ID)
+	client.Logger.Infof("Multiplexed account exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceMultiplexedAccountRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading multiplexed account: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading multiplexed account: %s", ResourceIDString(d))
 
 	// Create a NewMultiplexedAccount object and populate ID attribute
 	object := vault.NewMultiplexedAccount(client)
@@ -123,31 +123,33 @@ func resourceMultiplexedAccountRead(d *schema.ResourceData, m interface{}) error
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading multiplexed account: %v", err)
 	}
-	//logger.Debugf("Multiplexed account from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceMultiplexedAccountRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceMultiplexedAccountRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Debugf("Completed reading multiplexed account: %s", object.Name)
+	client.Logger.Debugf("Completed reading multiplexed account: %s", object.Name)
 	return nil
 }
 
 func resourceMultiplexedAccountCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning multiplexed account creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning multiplexed account creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a multiplexed account object and populate all attributes
 	object := vault.NewMultiplexedAccount(client)
 	err := createUpateGetMultiplexedAccountData(d, object)
@@ -178,17 +180,18 @@ func resourceMultiplexedAccountCreate(d *schema.ResourceData, m interface{}) err
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of multiplexed account completed: %s", object.Name)
+	client.Logger.Infof("Creation of multiplexed account completed: %s", object.Name)
 	return resourceMultiplexedAccountRead(d, m)
 }
 
 func resourceMultiplexedAccountUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning multiplexed account update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning multiplexed account update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewMultiplexedAccount(client)
 	object.ID = d.Id()
 	err := createUpateGetMultiplexedAccountData(d, object)
@@ -202,7 +205,7 @@ func resourceMultiplexedAccountUpdate(d *schema.ResourceData, m interface{}) err
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating multiplexed account attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	// Deal with Permissions
@@ -237,13 +240,14 @@ func resourceMultiplexedAccountUpdate(d *schema.ResourceData, m interface{}) err
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of multiplexed account completed: %s", object.Name)
+	client.Logger.Infof("Updating of multiplexed account completed: %s", object.Name)
 	return resourceMultiplexedAccountRead(d, m)
 }
 
 func resourceMultiplexedAccountDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of multiplexed account: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of multiplexed account: %s", ResourceIDString(d))
 
 	object := vault.NewMultiplexedAccount(client)
 	object.ID = d.Id()
@@ -260,7 +264,7 @@ func resourceMultiplexedAccountDelete(d *schema.ResourceData, m interface{}) err
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of multiplexed account completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of multiplexed account completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -146,27 +144,29 @@ func getPasswordProfileSchema() map[string]*schema.Schema {
 }
 
 func resourcePasswordProfileExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking password profile exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking password profile exist: %s", ResourceIDString(d))
 
 	object := vault.NewPasswordProfile(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Authentication password exists in tenant: %s", object.ID)
+	client.Logger.Infof("Authentication password exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourcePasswordProfileRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading password profile: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading password profile: %s", ResourceIDString(d))
 
 	// Create a password profile object and populate ID attribute
 	object := vault.NewPasswordProfile(client)
@@ -176,27 +176,30 @@ func resourcePasswordProfileRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading password profile: %v", err)
 	}
-	//logger.Debugf("password profile from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourcePasswordProfileRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourcePasswordProfileRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading password profile: %s", object.Name)
+	client.Logger.Infof("Completed reading password profile: %s", object.Name)
 	return nil
 }
 
 func resourcePasswordProfileDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of password profile: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of password profile: %s", ResourceIDString(d))
 
 	object := vault.NewPasswordProfile(client)
 	object.ID = d.Id()
@@ -212,14 +215,14 @@ func resourcePasswordProfileDelete(d *schema.ResourceData, m interface{}) error
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of password profile completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of password profile completed: %s", ResourceIDString(d))
 	return nil
 }
 
 func resourcePasswordProfileCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning password profile creation: %s", ResourceIDString(d))
-
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning password profile creation: %s", ResourceIDString(d))
 
 	// Create a password profile object and populate all attributes
 	object := vault.NewPasswordProfile(client)
@@ -239,14 +242,15 @@ func resourcePasswordProfileCreate(d *schema.ResourceData, m interface{}) error
 	object.ID = id
 
 	// Creation completed
-	logger.Infof("Creation of password profile completed: %s", object.Name)
+	client.Logger.Infof("Creation of password profile completed: %s", object.Name)
 	return resourcePasswordProfileRead(d, m)
 }
 
 func resourcePasswordProfileUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning password profile update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning password profile update: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewPasswordProfile(client)
 
 	object.ID = d.Id()
@@ -260,10 +264,10 @@ func resourcePasswordProfileUpdate(d *schema.ResourceData, m interface{}) error
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating password profile attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %+v", object)
+		client.Logger.Debugf("Updated attributes to: %+v", object)
 	}
 
-	logger.Infof("Updating of password profile completed: %s", object.Name)
+	client.Logger.Infof("Updating of password profile completed: %s", object.Name)
 	return resourcePasswordProfileRead(d, m)
 }
 
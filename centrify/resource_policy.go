@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -111,27 +110,29 @@ func getPolicySchema() map[string]*schema.Schema {
 }
 
 func resourcePolicyExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking policy exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking policy exist: %s", ResourceIDString(d))
 
 	object := vault.NewPolicy(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Policy exists in tenant: %s", object.ID)
+	client.Logger.Infof("Policy exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourcePolicyRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading policy: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading policy: %s", ResourceIDString(d))
 
 	// Create a policy object and populate ID attribute
 	object := vault.NewPolicy(client)
@@ -141,16 +142,18 @@ func resourcePolicyRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading policy: %v", err)
 	}
-	//logger.Debugf("Policy from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourcePolicyRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourcePolicyRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "path":
@@ -188,13 +191,14 @@ func resourcePolicyRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading policy: %s", object.Name)
+	client.Logger.Infof("Completed reading policy: %s", object.Name)
 	return nil
 }
 
 func resourcePolicyDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of policy: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of policy: %s", ResourceIDString(d))
 
 	object := vault.NewPolicy(client)
 	object.ID = d.Id()
@@ -211,14 +215,14 @@ func resourcePolicyDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of policy completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of policy completed: %s", ResourceIDString(d))
 	return nil
 }
 
 func resourcePolicyCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning policy creation: %s", ResourceIDString(d))
-
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning policy creation: %s", ResourceIDString(d))
 
 	// Create a policy object and populate all attributes
 	object := vault.NewPolicy(client)
@@ -242,14 +246,15 @@ func resourcePolicyCreate(d *schema.ResourceData, m interface{}) error {
 	object.ID = id
 
 	// Creation completed
-	logger.Infof("Creation of policy completed: %s", object.Name)
+	client.Logger.Infof("Creation of policy completed: %s", object.Name)
 	return resourcePolicyRead(d, m)
 }
 
 func resourcePolicyUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning policy update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning policy update: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewPolicy(client)
 
 	object.ID = d.Id()
@@ -265,10 +270,10 @@ func resourcePolicyUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating policy attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %+v", object)
+		client.Logger.Debugf("Updated attributes to: %+v", object)
 	}
 
-	logger.Infof("Updating of policy completed: %s", object.Name)
+	client.Logger.Infof("Updating of policy completed: %s", object.Name)
 	return resourcePolicyRead(d, m)
 }
 
@@ -328,7 +333,6 @@ func expandSettingsData(v interface{}) (*vault.PolicySettings, error) {
 		if data.CentrifyServices, err = expendCentrifyServicesData(d["centrify_services"]); err != nil {
 			return nil, err
 		}
-		logger.Debugf("data.CentrifyServices data: %+v", data.CentrifyServices)
 		if data.CentrifyClient, err = expandCentrifyClientData(d["centrify_client"]); err != nil {
 			return nil, err
 		}
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -51,8 +49,9 @@ func getPolicyLinksSchema() map[string]*schema.Schema {
 }
 
 func resourcePolicyLinksRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading policy links: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading policy links: %s", ResourceIDString(d))
 
 	// Create policy links object
 	object := vault.NewPolicyLinks(client)
@@ -61,6 +60,9 @@ func resourcePolicyLinksRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading policy: %v", err)
 	}
@@ -76,11 +78,12 @@ func resourcePolicyLinksRead(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePolicyLinksCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning policy links creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning policy links creation: %s", ResourceIDString(d))
 
 	d.SetId("centrifyvault_policy_links")
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewPolicyLinks(client)
 
 	// Upon creating policy links in local state, update the order in tenant as well
@@ -96,14 +99,15 @@ func resourcePolicyLinksCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// Creation completed
-	logger.Infof("Creation of policy links completed: %s", d.Id())
+	client.Logger.Infof("Creation of policy links completed: %s", d.Id())
 	return resourcePolicyLinksRead(d, m)
 }
 
 func resourcePolicyLinksUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning policy links update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning policy links update: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewPolicyLinks(client)
 
 	ids := d.Get("policy_order").([]interface{})
@@ -124,11 +128,11 @@ func resourcePolicyLinksUpdate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourcePolicyLinksDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of policy links: %s", ResourceIDString(d))
+	getLogger(m).Infof("Beginning deletion of policy links: %s", ResourceIDString(d))
 
 	// We do not actually delete anything from the tenant
 	d.SetId("")
 
-	logger.Infof("Deletion of policy links completed: %s", ResourceIDString(d))
+	getLogger(m).Infof("Deletion of policy links completed: %s", ResourceIDString(d))
 	return nil
 }
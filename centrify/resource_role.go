@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 	"log"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -20,7 +18,7 @@ func resourceRole_deprecated() *schema.Resource {
 		Delete: resourceRoleDelete,
 		Exists: resourceRoleExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("role", "<name>", lookupRoleID),
 		},
 
 		Schema:             getRoleSchema(),
@@ -36,7 +34,7 @@ func resourceRole() *schema.Resource {
 		Delete: resourceRoleDelete,
 		Exists: resourceRoleExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("role", "<name>", lookupRoleID),
 		},
 
 		Schema: getRoleSchema(),
@@ -95,27 +93,29 @@ func getRoleSchema() map[string]*schema.Schema {
 	}
 }
 func resourceRoleExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking role exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking role exist: %s", ResourceIDString(d))
 
 	object := vault.NewRole(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Role exists in tenant: %s", object.ID)
+	client.Logger.Infof("Role exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading role: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading role: %s", ResourceIDString(d))
 
 	// Create a role object and populate ID attribute
 	object := vault.NewRole(client)
@@ -125,32 +125,35 @@ func resourceRoleRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading role: %v", err)
 	}
-	logger.Debugf("Role from tenant: %v", object)
+	client.Logger.Debugf("Role from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceRoleRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceRoleRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading role: %s", object.Name)
+	client.Logger.Infof("Completed reading role: %s", object.Name)
 	return nil
 }
 
 func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Role creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Role creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a role object and populate all attributes
 	object := vault.NewRole(client)
 	createUpateGetRoleData(d, object)
@@ -170,7 +173,7 @@ func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
 	// Need to populate ID attribute otherwise AssignAdminRights function will fail
 	object.ID = id
 
-	logger.Debugf("Role created: %s", object.Name)
+	client.Logger.Debugf("Role created: %s", object.Name)
 
 	// Handle role members
 	if len(object.Members) > 0 {
@@ -187,22 +190,23 @@ func resourceRoleCreate(d *schema.ResourceData, m interface{}) error {
 			log.Fatalf("Error updating role admin rights: %v", err)
 			return nil
 		}
-		logger.Debugf("Updated admin rights to: %v", object.AdminRights)
+		client.Logger.Debugf("Updated admin rights to: %v", object.AdminRights)
 	}
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of role completed: %s", object.Name)
+	client.Logger.Infof("Creation of role completed: %s", object.Name)
 	return resourceRoleRead(d, m)
 }
 
 func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning role update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning role update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewRole(client)
 	object.ID = d.Id()
 	createUpateGetRoleData(d, object)
@@ -212,7 +216,7 @@ func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating role attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %+v", object)
+		client.Logger.Debugf("Updated attributes to: %+v", object)
 	}
 
 	// Deal with role members
@@ -237,14 +241,14 @@ func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil {
 			return fmt.Errorf(" Error getting existing role admin rights: %v", err)
 		}
-		logger.Debugf("Removing existing admin rights: %v", rights)
+		client.Logger.Debugf("Removing existing admin rights: %v", rights)
 		if rights != nil && len(rights) > 0 {
 			resp, err := object.RemoveAdminRights(rights)
 			if err != nil || !resp.Success {
 				return fmt.Errorf(" Error removing existing role admin rights: %v", err)
 			}
 		}
-		logger.Debugf("Removed existing admin rights: %v", rights)
+		client.Logger.Debugf("Removed existing admin rights: %v", rights)
 
 		// Set new admin rights
 		if d.Get("adminrights") != nil && d.Get("adminrights").(*schema.Set).Len() > 0 {
@@ -254,25 +258,26 @@ func resourceRoleUpdate(d *schema.ResourceData, m interface{}) error {
 				adminrights[i] = tfAdminRight.(string)
 			}
 			object.AdminRights = adminrights
-			logger.Debugf("Adding admin rights: %v", adminrights)
+			client.Logger.Debugf("Adding admin rights: %v", adminrights)
 
 			resp, err := object.AssignAdminRights()
 			if err != nil || !resp.Success {
 				return fmt.Errorf(" Error updating role admin rights: %v", err)
 			}
-			logger.Debugf("Updated admin rights to: %v", adminrights)
+			client.Logger.Debugf("Updated admin rights to: %v", adminrights)
 		}
 	}
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of role completed: %s", object.Name)
+	client.Logger.Infof("Updating of role completed: %s", object.Name)
 	return resourceRoleRead(d, m)
 }
 
 func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of role: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of role: %s", ResourceIDString(d))
 
 	object := vault.NewRole(client)
 	object.ID = d.Id()
@@ -288,7 +293,7 @@ func resourceRoleDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of role completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of role completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -0,0 +1,161 @@
+package centrify
+
+import (
+	"fmt"
+
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceRoleAdminRights() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRoleAdminRightsCreate,
+		Read:   resourceRoleAdminRightsRead,
+		Update: resourceRoleAdminRightsUpdate,
+		Delete: resourceRoleAdminRightsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: customizeRoleAdminRightsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"role_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the role",
+			},
+			"rights": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Administrative rights of the role",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+// customizeRoleAdminRightsDiff checks at plan time that admin rights are available in tenant
+func customizeRoleAdminRightsDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("rights") || !d.HasChange("rights") {
+		return nil
+	}
+	client := m.(*providerMeta).client
+	return vault.ValidateAdminRights(client, flattenSchemaSetToStringSlice(d.Get("rights")))
+}
+
+func resourceRoleAdminRightsRead(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading role admin rights: %s", ResourceIDString(d))
+
+	object := vault.NewRole(client)
+	object.ID = d.Id()
+	// Role is read as well so that deleted role is removed from state
+	err := object.Read()
+	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
+		return fmt.Errorf(" Error reading role admin rights: %v", err)
+	}
+
+	d.Set("role_id", object.ID)
+	d.Set("rights", object.AdminRights)
+
+	client.Logger.Infof("Completed reading role admin rights: %s", object.ID)
+	return nil
+}
+
+func resourceRoleAdminRightsCreate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning role admin rights creation: %s", ResourceIDString(d))
+
+	object := vault.NewRole(client)
+	object.ID = d.Get("role_id").(string)
+	if err := updateRoleAdminRights(object, flattenSchemaSetToStringSlice(d.Get("rights"))); err != nil {
+		return err
+	}
+
+	d.SetId(object.ID)
+	client.Logger.Infof("Creation of role admin rights completed: %s", object.ID)
+	return resourceRoleAdminRightsRead(d, m)
+}
+
+func resourceRoleAdminRightsUpdate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning role admin rights update: %s", ResourceIDString(d))
+
+	object := vault.NewRole(client)
+	object.ID = d.Id()
+	if d.HasChange("rights") {
+		if err := updateRoleAdminRights(object, flattenSchemaSetToStringSlice(d.Get("rights"))); err != nil {
+			return err
+		}
+	}
+
+	client.Logger.Infof("Updating of role admin rights completed: %s", object.ID)
+	return resourceRoleAdminRightsRead(d, m)
+}
+
+func resourceRoleAdminRightsDelete(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of role admin rights: %s", ResourceIDString(d))
+
+	object := vault.NewRole(client)
+	object.ID = d.Id()
+	if err := updateRoleAdminRights(object, nil); err != nil {
+		if restapi.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	d.SetId("")
+	client.Logger.Infof("Deletion of role admin rights completed: %s", ResourceIDString(d))
+	return nil
+}
+
+// updateRoleAdminRights makes admin rights of the role exactly the given rights
+func updateRoleAdminRights(object *vault.Role, rights []string) error {
+	current, err := object.GetAdminRights()
+	if err != nil {
+		return fmt.Errorf(" Error reading role admin rights: %w", err)
+	}
+
+	wanted := make(map[string]bool)
+	for _, v := range rights {
+		wanted[v] = true
+	}
+	remove := make(map[string]interface{})
+	for k, v := range current {
+		if !wanted[k] {
+			remove[k] = v
+		}
+	}
+	object.AdminRights = nil
+	for _, v := range rights {
+		if _, ok := current[v]; !ok {
+			object.AdminRights = append(object.AdminRights, v)
+		}
+	}
+
+	if len(remove) > 0 {
+		if _, err := object.RemoveAdminRights(remove); err != nil {
+			return fmt.Errorf(" Error removing role admin rights: %w", err)
+		}
+	}
+	if len(object.AdminRights) > 0 {
+		if _, err := object.AssignAdminRights(); err != nil {
+			return fmt.Errorf(" Error assigning role admin rights: %w", err)
+		}
+	}
+	return nil
+}
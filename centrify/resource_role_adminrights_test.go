@@ -0,0 +1,132 @@
+package centrify
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+var testAdminRights = map[string]string{
+	"Privileged Access Service User": "/lib/rights/privilegeservice.json",
+	"Report Management":              "/lib/rights/reportmanagement.json",
+	"User Management":                "/lib/rights/usermanagement.json",
+}
+
+// adminRightsTenant serves a single role whose admin rights are updated by AssignSuperRights and UnAssignSuperRights
+func adminRightsTenant(t *testing.T, assigned map[string]bool) (*providerMeta, *httptest.Server) {
+	rows := func(names map[string]bool) []interface{} {
+		results := []interface{}{}
+		for name := range names {
+			results = append(results, map[string]interface{}{"Row": map[string]interface{}{"Description": name, "Path": testAdminRights[name]}})
+		}
+		return results
+	}
+	update := func(assign bool) restapitest.Handler {
+		return func(r *restapitest.Request) interface{} {
+			var rights []struct{ Path string }
+			if err := r.Decode(&rights); err != nil {
+				t.Errorf("decoding request: %v", err)
+			}
+			for _, v := range rights {
+				var name string
+				for n, path := range testAdminRights {
+					if path == v.Path {
+						name = n
+					}
+				}
+				if name == "" {
+					t.Errorf("unknown admin right path %s", v.Path)
+				} else if assign {
+					assigned[name] = true
+				} else {
+					delete(assigned, name)
+				}
+			}
+			return nil
+		}
+	}
+	return testTenant(t, map[string]restapitest.Handler{
+		"/SaasManage/GetRole":        restapitest.Result(map[string]interface{}{"ID": "role-1", "Name": "Security"}),
+		"/SaasManage/GetRoleMembers": restapitest.Result(map[string]interface{}{"Results": []interface{}{}}),
+		"/Core/GetAssignedAdministrativeRights": func(r *restapitest.Request) interface{} {
+			return map[string]interface{}{"Results": rows(assigned)}
+		},
+		"/Redrock/Query": func(r *restapitest.Request) interface{} {
+			all := make(map[string]bool)
+			for name := range testAdminRights {
+				all[name] = true
+			}
+			return map[string]interface{}{"Results": rows(all)}
+		},
+		"/saasManage/AssignSuperRights":   update(true),
+		"/saasManage/UnAssignSuperRights": update(false),
+	})
+}
+
+func TestRoleAdminRights(t *testing.T) {
+	assigned := map[string]bool{"Report Management": true, "User Management": true}
+	meta, server := adminRightsTenant(t, assigned)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceRoleAdminRights().Schema, map[string]interface{}{
+		"role_id": "role-1",
+		"rights":  []interface{}{"User Management", "Privileged Access Service User"},
+	})
+	if err := resourceRoleAdminRightsCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"Privileged Access Service User", "User Management"}
+	rights := flattenSchemaSetToStringSlice(d.Get("rights"))
+	sort.Strings(rights)
+	if !reflect.DeepEqual(rights, expected) || len(assigned) != 2 || !assigned["Privileged Access Service User"] {
+		t.Errorf("expected rights %v, got %v in state and %v in tenant", expected, rights, assigned)
+	}
+
+	// Right assigned outside Terraform shows up as drift
+	assigned["Report Management"] = true
+	if err := resourceRoleAdminRightsRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if d.Get("rights").(*schema.Set).Len() != 3 {
+		t.Errorf("expected drift to be read, got %v", d.Get("rights"))
+	}
+
+	if err := resourceRoleAdminRightsDelete(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if len(assigned) != 0 {
+		t.Errorf("expected all rights to be removed, got %v", assigned)
+	}
+}
+
+func TestRoleAdminRightsPlanValidation(t *testing.T) {
+	meta, server := adminRightsTenant(t, map[string]bool{})
+	defer server.Close()
+
+	cases := []struct {
+		right    string
+		expected string
+	}{
+		{"Report Management", ""},
+		{"report management", "did you mean Report Management?"},
+		{"Printer Management", "valid admin rights are Privileged Access Service User,Report Management,User Management"},
+	}
+	for _, tc := range cases {
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"role_id": "role-1",
+			"rights":  []interface{}{tc.right},
+		})
+		_, err := resourceRoleAdminRights().Diff(nil, config, meta)
+		if tc.expected == "" && err != nil {
+			t.Errorf("%s: unexpected error %v", tc.right, err)
+		} else if tc.expected != "" && (err == nil || !strings.Contains(err.Error(), tc.expected)) {
+			t.Errorf("%s: expected error containing %q, got %v", tc.right, tc.expected, err)
+		}
+	}
+}
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -81,8 +79,9 @@ func getRoleMembershipSchema() map[string]*schema.Schema {
 }
 
 func resourceRoleMembershipRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading role membership: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading role membership: %s", ResourceIDString(d))
 
 	// Create a role object and populate ID attribute
 	object := vault.NewRoleMembership(client)
@@ -93,32 +92,35 @@ func resourceRoleMembershipRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading role: %v", err)
 	}
-	logger.Debugf("Role from tenant: %v", object)
+	client.Logger.Debugf("Role from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceRoleMembershipRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceRoleMembershipRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading role membership: %s", object.Name)
+	client.Logger.Infof("Completed reading role membership: %s", object.Name)
 	return nil
 }
 
 func resourceRoleMembershipCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning role membership creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning role membership creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a role object and populate all attributes
 	object := vault.NewRoleMembership(client)
 	createUpateGetRoleMembershipData(d, object)
@@ -135,17 +137,18 @@ func resourceRoleMembershipCreate(d *schema.ResourceData, m interface{}) error {
 	d.SetId(object.RoleID)
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of role membership completed: %s", object.Name)
+	client.Logger.Infof("Creation of role membership completed: %s", object.Name)
 	return resourceRoleMembershipRead(d, m)
 }
 
 func resourceRoleMembershipUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning role membership update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning role membership update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewRoleMembership(client)
 	object.ID = d.Id()
 	createUpateGetRoleMembershipData(d, object)
@@ -167,13 +170,14 @@ func resourceRoleMembershipUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of role membership completed: %s", object.Name)
+	client.Logger.Infof("Updating of role membership completed: %s", object.Name)
 	return resourceRoleMembershipRead(d, m)
 }
 
 func resourceRoleMembershipDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of role membership: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of role membership: %s", ResourceIDString(d))
 
 	object := vault.NewRoleMembership(client)
 	object.ID = d.Id()
@@ -187,7 +191,7 @@ func resourceRoleMembershipDelete(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.SetId("")
-	logger.Infof("Deletion of role membership completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of role membership completed: %s", ResourceIDString(d))
 	return nil
 }
 
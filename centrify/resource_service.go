@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/servicetype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -139,27 +138,29 @@ func getServiceSchema() map[string]*schema.Schema {
 }
 
 func resourceServiceExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking service exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking service exist: %s", ResourceIDString(d))
 
 	object := vault.NewService(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Service exists in tenant: %s", object.ID)
+	client.Logger.Infof("Service exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceServiceRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading service: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading service: %s", ResourceIDString(d))
 
 	// Create a NewService object and populate ID attribute
 	object := vault.NewService(client)
@@ -169,15 +170,17 @@ func resourceServiceRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading service: %v", err)
 	}
-	//logger.Debugf("Service from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceServiceRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceServiceRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		if k == "days_of_week" {
 			// Convert "value1,value1" to schema.TypeSet
@@ -187,18 +190,18 @@ func resourceServiceRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading service: %s", object.Name)
+	client.Logger.Infof("Completed reading service: %s", object.Name)
 	return nil
 }
 
 func resourceServiceCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning service creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning service creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a service object and populate all attributes
 	object := vault.NewService(client)
 	err := createUpateGetServiceData(d, object)
@@ -237,17 +240,18 @@ func resourceServiceCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of service completed: %s", object.Name)
+	client.Logger.Infof("Creation of service completed: %s", object.Name)
 	return resourceServiceRead(d, m)
 }
 
 func resourceServiceUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning service update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning service update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewService(client)
 	object.ID = d.Id()
 	err := createUpateGetServiceData(d, object)
@@ -262,7 +266,7 @@ func resourceServiceUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating service attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	// Deal with Set member
@@ -322,13 +326,14 @@ func resourceServiceUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of service completed: %s", object.Name)
+	client.Logger.Infof("Updating of service completed: %s", object.Name)
 	return resourceServiceRead(d, m)
 }
 
 func resourceServiceDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of service: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of service: %s", ResourceIDString(d))
 
 	object := vault.NewService(client)
 	object.ID = d.Id()
@@ -345,7 +350,7 @@ func resourceServiceDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of service completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of service completed: %s", ResourceIDString(d))
 	return nil
 }
 
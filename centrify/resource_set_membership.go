@@ -0,0 +1,232 @@
+package centrify
+
+import (
+	"fmt"
+
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceSetMembership() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSetMembershipCreate,
+		Read:   resourceSetMembershipRead,
+		Update: resourceSetMembershipUpdate,
+		Delete: resourceSetMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			State: importSetMembership,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"set_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the manual set",
+			},
+			"members": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "IDs of objects that are members of the set",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"authoritative": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether members that aren't in members are removed from the set",
+			},
+		},
+	}
+}
+
+// getMembershipSet reads the set and makes sure it is a manual set whose members can be updated
+func getMembershipSet(client *restapi.RestClient, id string) (*vault.ManualSet, error) {
+	object := vault.NewManualSet(client)
+	object.ID = id
+	if err := object.Read(); err != nil {
+		return nil, err
+	}
+	if object.CollectionType != "ManualBucket" {
+		return nil, fmt.Errorf(" Set %s is a %s set, only members of manual set can be managed", object.Name, object.CollectionType)
+	}
+	return object, nil
+}
+
+// diffSetMembers returns members to be added and removed to get from old to new
+func diffSetMembers(old []string, new []string) (add []string, remove []string) {
+	oldMap := make(map[string]bool)
+	for _, v := range old {
+		oldMap[v] = true
+	}
+	newMap := make(map[string]bool)
+	for _, v := range new {
+		newMap[v] = true
+		if !oldMap[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range old {
+		if !newMap[v] {
+			remove = append(remove, v)
+		}
+	}
+	return
+}
+
+func resourceSetMembershipRead(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading set membership: %s", ResourceIDString(d))
+
+	object, err := getMembershipSet(client, d.Id())
+	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
+		return fmt.Errorf(" Error reading set membership: %v", err)
+	}
+
+	members, err := object.GetSetMembers()
+	if err != nil {
+		return fmt.Errorf(" Error reading set members: %v", err)
+	}
+
+	// In non-authoritative mode, only members managed by this resource are tracked
+	// so that members added by others don't show up as drift
+	if !d.Get("authoritative").(bool) {
+		managed := d.Get("members").(*schema.Set)
+		var tracked []string
+		for _, v := range members {
+			if managed.Contains(v) {
+				tracked = append(tracked, v)
+			}
+		}
+		members = tracked
+	}
+
+	d.Set("set_id", object.ID)
+	d.Set("members", members)
+
+	client.Logger.Infof("Completed reading set membership: %s", object.Name)
+	return nil
+}
+
+func resourceSetMembershipCreate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning set membership creation: %s", ResourceIDString(d))
+
+	object, err := getMembershipSet(client, d.Get("set_id").(string))
+	if err != nil {
+		return fmt.Errorf(" Error reading set: %v", err)
+	}
+
+	current, err := object.GetSetMembers()
+	if err != nil {
+		return fmt.Errorf(" Error reading set members: %v", err)
+	}
+	add, remove := diffSetMembers(current, flattenSchemaSetToStringSlice(d.Get("members")))
+	if !d.Get("authoritative").(bool) {
+		remove = nil
+	}
+	if err := updateSetMembers(object, add, remove); err != nil {
+		return err
+	}
+
+	d.SetId(object.ID)
+	client.Logger.Infof("Creation of set membership completed: %s", object.Name)
+	return resourceSetMembershipRead(d, m)
+}
+
+func resourceSetMembershipUpdate(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning set membership update: %s", ResourceIDString(d))
+
+	object, err := getMembershipSet(client, d.Id())
+	if err != nil {
+		return fmt.Errorf(" Error reading set: %v", err)
+	}
+
+	old := flattenSchemaSetToStringSlice(d.Get("members"))
+	if d.HasChange("members") {
+		o, _ := d.GetChange("members")
+		old = flattenSchemaSetToStringSlice(o)
+	}
+	// Switching to authoritative mode removes members that aren't managed yet
+	if d.HasChange("authoritative") && d.Get("authoritative").(bool) {
+		old, err = object.GetSetMembers()
+		if err != nil {
+			return fmt.Errorf(" Error reading set members: %v", err)
+		}
+	}
+	add, remove := diffSetMembers(old, flattenSchemaSetToStringSlice(d.Get("members")))
+	if err := updateSetMembers(object, add, remove); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Updating of set membership completed: %s", object.Name)
+	return resourceSetMembershipRead(d, m)
+}
+
+func resourceSetMembershipDelete(d *schema.ResourceData, m interface{}) error {
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of set membership: %s", ResourceIDString(d))
+
+	object, err := getMembershipSet(client, d.Id())
+	if err != nil {
+		if restapi.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" Error reading set: %v", err)
+	}
+
+	// Only members in state are removed, the set itself is kept
+	if err := updateSetMembers(object, nil, flattenSchemaSetToStringSlice(d.Get("members"))); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	client.Logger.Infof("Deletion of set membership completed: %s", ResourceIDString(d))
+	return nil
+}
+
+// importSetMembership imports all current members of the set in non-authoritative mode
+func importSetMembership(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+
+	object, err := getMembershipSet(client, d.Id())
+	if err != nil {
+		return nil, fmt.Errorf(" Error importing set membership: %v", err)
+	}
+	members, err := object.GetSetMembers()
+	if err != nil {
+		return nil, fmt.Errorf(" Error reading set members: %v", err)
+	}
+	d.Set("set_id", object.ID)
+	d.Set("members", members)
+	d.Set("authoritative", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateSetMembers(object *vault.ManualSet, add []string, remove []string) error {
+	if len(remove) > 0 {
+		if _, err := object.UpdateSetMembers(remove, "remove"); err != nil {
+			return fmt.Errorf(" Failed to remove members from set: %v", err)
+		}
+	}
+	if len(add) > 0 {
+		if _, err := object.UpdateSetMembers(add, "add"); err != nil {
+			return fmt.Errorf(" Failed to add members to set: %v", err)
+		}
+	}
+	return nil
+}
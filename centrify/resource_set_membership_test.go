@@ -0,0 +1,164 @@
+package centrify
+
+import (
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// setTenant serves a single set whose members are updated by UpdateMembersCollection
+func setTenant(t *testing.T, collectionType string, members map[string]bool) (*providerMeta, *httptest.Server) {
+	return testTenant(t, map[string]restapitest.Handler{
+		"/Collection/GetCollection": restapitest.Result(map[string]interface{}{
+			"ID": "set-1", "Name": "Web Servers", "ObjectType": "Server", "CollectionType": collectionType,
+		}),
+		"/Collection/GetMembers": func(r *restapitest.Request) interface{} {
+			rows := []interface{}{}
+			for id := range members {
+				rows = append(rows, map[string]interface{}{"Key": id, "Table": "Server", "MemberType": "Row"})
+			}
+			return rows
+		},
+		"/Collection/UpdateMembersCollection": func(r *restapitest.Request) interface{} {
+			add, _ := r.Args["add"].([]interface{})
+			remove, _ := r.Args["remove"].([]interface{})
+			for _, member := range add {
+				members[member.(map[string]interface{})["Key"].(string)] = true
+			}
+			for _, member := range remove {
+				delete(members, member.(map[string]interface{})["Key"].(string))
+			}
+			return ""
+		},
+		"/Collection/GetObjectCollectionsAndFilters": func(r *restapitest.Request) interface{} {
+			results := []interface{}{}
+			if members[r.Args["ID"].(string)] {
+				results = append(results, map[string]interface{}{"Row": map[string]interface{}{"ID": "set-1", "CollectionType": collectionType}})
+			}
+			return map[string]interface{}{"Results": results}
+		},
+	})
+}
+
+func sortedMembers(d *schema.ResourceData) []string {
+	members := flattenSchemaSetToStringSlice(d.Get("members"))
+	sort.Strings(members)
+	return members
+}
+
+func TestSetMembership(t *testing.T) {
+	cases := []struct {
+		name          string
+		authoritative bool
+		expected      []string
+		tenant        []string
+	}{
+		{"non-authoritative", false, []string{"m1", "m2"}, []string{"m1", "m2", "other"}},
+		{"authoritative", true, []string{"m1", "m2"}, []string{"m1", "m2"}},
+	}
+	for _, tc := range cases {
+		members := map[string]bool{"m1": true, "other": true}
+		meta, server := setTenant(t, "ManualBucket", members)
+
+		d := schema.TestResourceDataRaw(t, resourceSetMembership().Schema, map[string]interface{}{
+			"set_id":        "set-1",
+			"members":       []interface{}{"m1", "m2"},
+			"authoritative": tc.authoritative,
+		})
+		if err := resourceSetMembershipCreate(d, meta); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got := sortedMembers(d); !reflect.DeepEqual(got, tc.expected) {
+			t.Errorf("%s: expected members %v in state, got %v", tc.name, tc.expected, got)
+		}
+		var tenant []string
+		for id := range members {
+			tenant = append(tenant, id)
+		}
+		sort.Strings(tenant)
+		if !reflect.DeepEqual(tenant, tc.tenant) {
+			t.Errorf("%s: expected members %v in tenant, got %v", tc.name, tc.tenant, tenant)
+		}
+
+		// Members added and removed outside Terraform
+		members["added"] = true
+		delete(members, "m2")
+		if err := resourceSetMembershipRead(d, meta); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		expected := []string{"m1"}
+		if tc.authoritative {
+			expected = []string{"added", "m1"}
+		}
+		if got := sortedMembers(d); !reflect.DeepEqual(got, expected) {
+			t.Errorf("%s: expected drift %v, got %v", tc.name, expected, got)
+		}
+
+		if err := resourceSetMembershipDelete(d, meta); err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if members["m1"] || (tc.authoritative && members["added"]) || (!tc.authoritative && !members["other"]) {
+			t.Errorf("%s: unexpected members after delete %v", tc.name, members)
+		}
+		server.Close()
+	}
+}
+
+func TestSetMembershipRejectsDynamicSet(t *testing.T) {
+	meta, server := setTenant(t, "SqlDynamic", map[string]bool{})
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceSetMembership().Schema, map[string]interface{}{
+		"set_id":  "set-1",
+		"members": []interface{}{"m1"},
+	})
+	err := resourceSetMembershipCreate(d, meta)
+	if err == nil || !strings.Contains(err.Error(), "only members of manual set") {
+		t.Errorf("expected dynamic set to be rejected, got %v", err)
+	}
+}
+
+func TestSetMembershipWithMemberResource(t *testing.T) {
+	members := map[string]bool{}
+	meta, server := setTenant(t, "ManualBucket", members)
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceSetMembership().Schema, map[string]interface{}{
+		"set_id":  "set-1",
+		"members": []interface{}{"system-1"},
+	})
+	if err := resourceSetMembershipCreate(d, meta); err != nil {
+		t.Fatal(err)
+	}
+
+	// Member resource doesn't pick up membership managed by centrify_set_membership, whether it has sets or not,
+	// so its next apply doesn't remove it
+	for _, sets := range [][]interface{}{nil, {"set-2"}} {
+		config := map[string]interface{}{"name": "web1"}
+		if sets != nil {
+			config["sets"] = sets
+		}
+		system := schema.TestResourceDataRaw(t, resourceSystem().Schema, config)
+		object := vault.NewSystem(meta.client)
+		object.ID = "system-1"
+		if err := readSets(system, object); err != nil {
+			t.Fatal(err)
+		}
+		if got := flattenSchemaSetToStringSlice(system.Get("sets")); len(got) != 0 {
+			t.Errorf("expected system not to track set-1, got sets %v", got)
+		}
+	}
+
+	if err := resourceSetMembershipRead(d, meta); err != nil {
+		t.Fatal(err)
+	}
+	if got := sortedMembers(d); !reflect.DeepEqual(got, []string{"system-1"}) || !members["system-1"] {
+		t.Errorf("expected system-1 to stay member, got %v", got)
+	}
+}
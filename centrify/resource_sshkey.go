@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -18,7 +16,7 @@ func resourceSSHKey_deprecated() *schema.Resource {
 		Delete: resourceSSHKeyDelete,
 		Exists: resourceSSHKeyExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("sshkey", "<name>", lookupSSHKeyID),
 		},
 
 		Schema:             getSSHKeySchema(),
@@ -34,7 +32,7 @@ func resourceSSHKey() *schema.Resource {
 		Delete: resourceSSHKeyDelete,
 		Exists: resourceSSHKeyExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("sshkey", "<name>", lookupSSHKeyID),
 		},
 
 		Schema: getSSHKeySchema(),
@@ -92,27 +90,29 @@ func getSSHKeySchema() map[string]*schema.Schema {
 }
 
 func resourceSSHKeyExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking SSH Key exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking SSH Key exist: %s", ResourceIDString(d))
 
 	object := vault.NewSSHKey(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("SSH Key exists in tenant: %s", object.ID)
+	client.Logger.Infof("SSH Key exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading SSH Key: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading SSH Key: %s", ResourceIDString(d))
 
 	// Create a new SSHKey object and populate ID attribute
 	object := vault.NewSSHKey(client)
@@ -122,15 +122,17 @@ func resourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading SSH Key: %v", err)
 	}
-	//logger.Debugf("SSH Key from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceSSHKeyRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceSSHKeyRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -140,18 +142,27 @@ func resourceSSHKeyRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading SSH Key: %s", object.Name)
+	clearAbsentAttributes(d, schemamap, "challenge_rule")
+
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+	if err := readSets(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading SSH Key: %s", object.Name)
 	return nil
 }
 
 func resourceSSHKeyCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning SSH Key creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning SSH Key creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a SSH Key object and populate all attributes
 	object := vault.NewSSHKey(client)
 	err := createUpateGetSSHKeyData(d, object)
@@ -199,17 +210,18 @@ func resourceSSHKeyCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of SSH Key completed: %s", object.Name)
+	client.Logger.Infof("Creation of SSH Key completed: %s", object.Name)
 	return resourceSSHKeyRead(d, m)
 }
 
 func resourceSSHKeyUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning SSH Key update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning SSH Key update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewSSHKey(client)
 	object.ID = d.Id()
 	err := createUpateGetSSHKeyData(d, object)
@@ -227,7 +239,7 @@ func resourceSSHKeyUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating SSH Key attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("sets") {
@@ -286,13 +298,14 @@ func resourceSSHKeyUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of SSH Key completed: %s", object.Name)
+	client.Logger.Infof("Updating of SSH Key completed: %s", object.Name)
 	return resourceSSHKeyRead(d, m)
 }
 
 func resourceSSHKeyDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of SSH Key: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of SSH Key: %s", ResourceIDString(d))
 
 	object := vault.NewSSHKey(client)
 	object.ID = d.Id()
@@ -319,7 +332,7 @@ func resourceSSHKeyDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of SSH Key completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of SSH Key completed: %s", ResourceIDString(d))
 	return nil
 }
 
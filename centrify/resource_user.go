@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -18,7 +16,7 @@ func resourceUser_deprecated() *schema.Resource {
 		Delete: resourceUserDelete,
 		Exists: resourceUserExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("user", "<username>", lookupUserID),
 		},
 
 		Schema:             getUserSchema(),
@@ -34,7 +32,7 @@ func resourceUser() *schema.Resource {
 		Delete: resourceUserDelete,
 		Exists: resourceUserExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("user", "<username>", lookupUserID),
 		},
 
 		Schema: getUserSchema(),
@@ -137,27 +135,29 @@ func getUserSchema() map[string]*schema.Schema {
 }
 
 func resourceUserExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking user exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking user exist: %s", ResourceIDString(d))
 
 	object := vault.NewUser(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("User exists in tenant: %s", object.ID)
+	client.Logger.Infof("User exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceUserRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading user: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading user: %s", ResourceIDString(d))
 
 	// Create a NewUser object and populate ID attribute
 	object := vault.NewUser(client)
@@ -167,30 +167,32 @@ func resourceUserRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading user: %v", err)
 	}
-	//logger.Debugf("User from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceUserRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceUserRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading user: %s", object.Name)
+	client.Logger.Infof("Completed reading user: %s", object.Name)
 	return nil
 }
 
 func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning user creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning user creation: %s", ResourceIDString(d))
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a NewUser object and populate all attributes
 	object := vault.NewUser(client)
 	createUpateGetUserData(d, object)
@@ -222,17 +224,18 @@ func resourceUserCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of user completed: %s", object.Name)
+	client.Logger.Infof("Creation of user completed: %s", object.Name)
 	return resourceUserRead(d, m)
 }
 
 func resourceUserUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning user update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning user update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewUser(client)
 	object.ID = d.Id()
 	createUpateGetUserData(d, object)
@@ -251,7 +254,7 @@ func resourceUserUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating user attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %+v", object)
+		client.Logger.Debugf("Updated attributes to: %+v", object)
 	}
 
 	// Change password
@@ -287,13 +290,14 @@ func resourceUserUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of user completed: %s", object.Name)
+	client.Logger.Infof("Updating of user completed: %s", object.Name)
 	return resourceUserRead(d, m)
 }
 
 func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of user: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of user: %s", ResourceIDString(d))
 
 	object := vault.NewUser(client)
 	object.ID = d.Id()
@@ -309,7 +313,7 @@ func resourceUserDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of user completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of user completed: %s", ResourceIDString(d))
 	return nil
 }
 
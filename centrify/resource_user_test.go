@@ -6,7 +6,6 @@ import (
 	"testing"
 
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -46,7 +45,7 @@ func testAccBasicValExists(rName string) string {
 }
 
 func testAccCheckUserDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*restapi.RestClient)
+	client := testAccProvider.Meta().(*providerMeta).client
 	object := vault.NewUser(client)
 	for _, res := range s.RootModule().Resources {
 		if res.Type != "centrify_user" {
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -50,8 +48,9 @@ func getUserPasswordSchema() map[string]*schema.Schema {
 }
 
 func resourceUserPasswordRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading user password: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading user password: %s", ResourceIDString(d))
 
 	// Create a NewUser object and populate ID attribute
 	object := vault.NewUser(client)
@@ -61,26 +60,29 @@ func resourceUserPasswordRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading user: %v", err)
 	}
-	//logger.Debugf("User from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceUserPasswordRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceUserPasswordRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		d.Set(k, v)
 	}
 
-	logger.Infof("Completed reading user password: %s", object.Name)
+	client.Logger.Infof("Completed reading user password: %s", object.Name)
 	return nil
 }
 
 func resourceUserPasswordCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning user password creation: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning user password creation: %s", ResourceIDString(d))
 
 	// Create a NewUser object and populate all attributes
 	object := vault.NewUser(client)
@@ -97,14 +99,15 @@ func resourceUserPasswordCreate(d *schema.ResourceData, m interface{}) error {
 
 	d.SetId(object.ID)
 
-	logger.Infof("Creation of user password completed: %s", object.Name)
+	client.Logger.Infof("Creation of user password completed: %s", object.Name)
 	return resourceUserPasswordRead(d, m)
 }
 
 func resourceUserPasswordUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning user password update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning user password update: %s", ResourceIDString(d))
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewUser(client)
 	object.ID = d.Id()
 	createUpateGetUserPasswordData(d, object)
@@ -117,19 +120,20 @@ func resourceUserPasswordUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Updating of user password completed: %s", object.Name)
+	client.Logger.Infof("Updating of user password completed: %s", object.Name)
 	return resourceUserPasswordRead(d, m)
 }
 
 func resourceUserPasswordDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of user: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of user: %s", ResourceIDString(d))
 
 	object := vault.NewUser(client)
 	object.ID = d.Id()
 
 	d.SetId("")
-	logger.Infof("Deletion of user password completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of user password completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -19,7 +17,7 @@ func resourceAccount_deprecated() *schema.Resource {
 		Delete: resourceAccountDelete,
 		Exists: resourceAccountExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("account", "<resourcetype>/<resource>/<user>", lookupAccountID),
 		},
 
 		Schema:             getAccountSchema(),
@@ -38,7 +36,7 @@ func resourceAccount() *schema.Resource {
 		Delete: resourceAccountDelete,
 		Exists: resourceAccountExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("account", "<resourcetype>/<resource>/<user>", lookupAccountID),
 		},
 
 		Schema: getAccountSchema(),
@@ -181,27 +179,29 @@ func getAccountSchema() map[string]*schema.Schema {
 }
 
 func resourceAccountExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Account exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Account exist: %s", ResourceIDString(d))
 
 	object := vault.NewAccount(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Account exists in tenant: %s", object.ID)
+	client.Logger.Infof("Account exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Account: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Account: %s", ResourceIDString(d))
 
 	// Create a NewAccount object and populate ID attribute
 	object := vault.NewAccount(client)
@@ -211,15 +211,17 @@ func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading Account: %v", err)
 	}
-	//logger.Debugf("Account from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceAccountRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceAccountRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule", "access_secret_checkout_rule":
@@ -244,14 +246,24 @@ func resourceAccountRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading Account: %s", object.Name)
+	clearAbsentAttributes(d, schemamap, "challenge_rule", "access_secret_checkout_rule")
+
+	object.ResolveValidPermissions()
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+	if err := readSets(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading Account: %s", object.Name)
 	return nil
 }
 
 func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Account creation: %s", ResourceIDString(d))
-
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Account creation: %s", ResourceIDString(d))
 
 	// Create an Account object and populate all attributes
 	object := vault.NewAccount(client)
@@ -308,7 +320,7 @@ func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
 
 	// add IAM account access key
 	if len(object.AccessKeys) > 0 {
-		logger.Debugf("Adding access key...")
+		client.Logger.Debugf("Adding access key...")
 		for _, v := range object.AccessKeys {
 			err := object.SafeAddAccessKey(v)
 			if err != nil {
@@ -318,17 +330,18 @@ func resourceAccountCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	// Creation completed
-	logger.Infof("Creation of Account completed: %s", object.User)
+	client.Logger.Infof("Creation of Account completed: %s", object.User)
 	return resourceAccountRead(d, m)
 }
 
 func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Account update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Account update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewAccount(client)
 	object.ID = d.Id()
 	err := createUpateGetAccountData(d, object)
@@ -351,7 +364,7 @@ func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating Account attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	// Deal with Set member
@@ -438,7 +451,7 @@ func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 				if err != nil {
 					return fmt.Errorf(" Error deleting access key %s : %v", keyid, err)
 				}
-				logger.Debugf("Deleted old key: %+v", keyid)
+				client.Logger.Debugf("Deleted old key: %+v", keyid)
 			}
 		}
 
@@ -455,20 +468,21 @@ func resourceAccountUpdate(d *schema.ResourceData, m interface{}) error {
 				if err != nil {
 					return fmt.Errorf(" Error adding access key %s : %v", keyid, err)
 				}
-				logger.Debugf("Added new key: %+v", keyid)
+				client.Logger.Debugf("Added new key: %+v", keyid)
 			}
 		}
 	}
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of Account completed: %s", object.Name)
+	client.Logger.Infof("Updating of Account completed: %s", object.Name)
 	return resourceAccountRead(d, m)
 }
 
 func resourceAccountDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Account: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Account: %s", ResourceIDString(d))
 
 	object := vault.NewAccount(client)
 	object.ID = d.Id()
@@ -497,7 +511,7 @@ func resourceAccountDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Account completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Account completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -596,7 +610,6 @@ func createUpateGetAccountData(d *schema.ResourceData, object *vault.Account) er
 	// Perform validations
 	if object.ID == "" {
 		if err := object.ValidateCredentialType(); err != nil {
-			logger.Errorf("there is error: %s", err)
 			return fmt.Errorf(" Schema setting error: %s", err)
 		}
 	}
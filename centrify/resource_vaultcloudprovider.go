@@ -2,10 +2,8 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/cloudprovidertype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -20,7 +18,7 @@ func resourceCloudProvider_deprecated() *schema.Resource {
 		Delete: resourceCloudProviderDelete,
 		Exists: resourceCloudProviderExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("cloudprovider", "<cloud account id>/<name>", lookupCloudProviderID),
 		},
 
 		Schema:             getCloudProviderSchema(),
@@ -36,7 +34,7 @@ func resourceCloudProvider() *schema.Resource {
 		Delete: resourceCloudProviderDelete,
 		Exists: resourceCloudProviderExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("cloudprovider", "<cloud account id>/<name>", lookupCloudProviderID),
 		},
 
 		Schema: getCloudProviderSchema(),
@@ -111,27 +109,29 @@ func getCloudProviderSchema() map[string]*schema.Schema {
 }
 
 func resourceCloudProviderExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking CloudProvider exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking CloudProvider exist: %s", ResourceIDString(d))
 
 	object := vault.NewCloudProvider(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("CloudProvider exists in tenant: %s", object.ID)
+	client.Logger.Infof("CloudProvider exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceCloudProviderRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading CloudProvider: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading CloudProvider: %s", ResourceIDString(d))
 
 	// Create a System object and populate ID attribute
 	object := vault.NewCloudProvider(client)
@@ -141,16 +141,18 @@ func resourceCloudProviderRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading System: %v", err)
 	}
-	//logger.Debugf("System from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceCloudProviderRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceCloudProviderRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -160,18 +162,27 @@ func resourceCloudProviderRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading CloudProvider: %s", object.Name)
+	clearAbsentAttributes(d, schemamap, "challenge_rule")
+
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+	if err := readSets(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading CloudProvider: %s", object.Name)
 	return nil
 }
 
 func resourceCloudProviderCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning CloudProvider creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning CloudProvider creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a CloudProvider object and populate all attributes
 	object := vault.NewCloudProvider(client)
 	err := createUpateGetCloudProviderData(d, object)
@@ -195,7 +206,7 @@ func resourceCloudProviderCreate(d *schema.ResourceData, m interface{}) error {
 	// 2nd step to update CloudProvider login profile
 	// Create API call doesn't set CloudProvider login profile so need to run update again
 	if object.LoginDefaultProfile != "" {
-		logger.Debugf("Update login profile for CloudProvider creation: %s", ResourceIDString(d))
+		client.Logger.Debugf("Update login profile for CloudProvider creation: %s", ResourceIDString(d))
 		resp, err := object.Update()
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating System attribute: %v", err)
@@ -220,17 +231,18 @@ func resourceCloudProviderCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of CloudProvider completed: %s", object.Name)
+	client.Logger.Infof("Creation of CloudProvider completed: %s", object.Name)
 	return resourceCloudProviderRead(d, m)
 }
 
 func resourceCloudProviderUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning CloudProvider update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning CloudProvider update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewCloudProvider(client)
 
 	object.ID = d.Id()
@@ -250,7 +262,6 @@ func resourceCloudProviderUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating CloudProvider attribute: %v", err)
 		}
-		//logger.Debugf("Updated attributes to: %+v", object)
 	}
 
 	// Deal with Set member
@@ -309,13 +320,14 @@ func resourceCloudProviderUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Partial(false)
-	logger.Infof("Updating of CloudProvider completed: %s", object.Name)
+	client.Logger.Infof("Updating of CloudProvider completed: %s", object.Name)
 	return resourceCloudProviderRead(d, m)
 }
 
 func resourceCloudProviderDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of CloudProvider: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of CloudProvider: %s", ResourceIDString(d))
 
 	object := vault.NewCloudProvider(client)
 	object.ID = d.Id()
@@ -331,7 +343,7 @@ func resourceCloudProviderDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of CloudProvider completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of CloudProvider completed: %s", ResourceIDString(d))
 	return nil
 }
 
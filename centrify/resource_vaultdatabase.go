@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/databaseclass"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -20,7 +19,7 @@ func resourceDatabase_deprecated() *schema.Resource {
 		Delete: resourceDatabaseDelete,
 		Exists: resourceDatabaseExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("database", "<name>", lookupDatabaseID),
 		},
 
 		Schema:             getDatabaseSchema(),
@@ -36,7 +35,7 @@ func resourceDatabase() *schema.Resource {
 		Delete: resourceDatabaseDelete,
 		Exists: resourceDatabaseExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("database", "<name>", lookupDatabaseID),
 		},
 
 		Schema: getDatabaseSchema(),
@@ -170,27 +169,29 @@ func getDatabaseSchema() map[string]*schema.Schema {
 }
 
 func resourceDatabaseExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Database exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Database exist: %s", ResourceIDString(d))
 
 	object := vault.NewDatabase(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Database exists in tenant: %s", object.ID)
+	client.Logger.Infof("Database exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceDatabaseRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Database: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Database: %s", ResourceIDString(d))
 
 	// Create a Database object and populate ID attribute
 	object := vault.NewDatabase(client)
@@ -200,16 +201,18 @@ func resourceDatabaseRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading Database: %v", err)
 	}
-	//logger.Debugf("Database from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceDatabaseRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceDatabaseRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		if k == "connector_list" {
 			// Convert "value1,value1" to schema.TypeSet
@@ -219,18 +222,25 @@ func resourceDatabaseRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading Database: %s", object.Name)
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+	if err := readSets(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading Database: %s", object.Name)
 	return nil
 }
 
 func resourceDatabaseCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Database creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Database creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a Database object and populate all attributes
 	object := vault.NewDatabase(client)
 	err := createUpateGetDatabaseData(d, object)
@@ -276,17 +286,18 @@ func resourceDatabaseCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of Database completed: %s", object.Name)
+	client.Logger.Infof("Creation of Database completed: %s", object.Name)
 	return resourceDatabaseRead(d, m)
 }
 
 func resourceDatabaseUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Database update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Database update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewDatabase(client)
 
 	object.ID = d.Id()
@@ -304,7 +315,7 @@ func resourceDatabaseUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating Database attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %+v", object)
+		client.Logger.Debugf("Updated attributes to: %+v", object)
 	}
 
 	// Deal with Set member
@@ -363,13 +374,14 @@ func resourceDatabaseUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Partial(false)
-	logger.Infof("Updating of Database completed: %s", object.Name)
+	client.Logger.Infof("Updating of Database completed: %s", object.Name)
 	return resourceDatabaseRead(d, m)
 }
 
 func resourceDatabaseDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Database: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Database: %s", ResourceIDString(d))
 
 	object := vault.NewDatabase(client)
 	object.ID = d.Id()
@@ -385,7 +397,7 @@ func resourceDatabaseDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Database completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Database completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -19,7 +18,7 @@ func resourceDomain_deprecated() *schema.Resource {
 		Delete: resourceDomainDelete,
 		Exists: resourceDomainExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("domain", "<name>", lookupDomainID),
 		},
 
 		Schema:             getDomainSchema(),
@@ -35,7 +34,7 @@ func resourceDomain() *schema.Resource {
 		Delete: resourceDomainDelete,
 		Exists: resourceDomainExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("domain", "<name>", lookupDomainID),
 		},
 
 		Schema: getDomainSchema(),
@@ -214,27 +213,29 @@ func getDomainSchema() map[string]*schema.Schema {
 }
 
 func resourceDomainExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Domain exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Domain exist: %s", ResourceIDString(d))
 
 	object := vault.NewDomain(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Domain exists in tenant: %s", object.ID)
+	client.Logger.Infof("Domain exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceDomainRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Domain: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Domain: %s", ResourceIDString(d))
 
 	// Create a Domain object and populate ID attribute
 	object := vault.NewDomain(client)
@@ -244,16 +245,18 @@ func resourceDomainRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading Domain: %v", err)
 	}
-	//logger.Debugf("Domain from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceDomainRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceDomainRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		if k == "connector_list" {
 			// Convert "value1,value1" to schema.TypeSet
@@ -263,18 +266,25 @@ func resourceDomainRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading Domain: %s", object.Name)
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+	if err := readSets(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading Domain: %s", object.Name)
 	return nil
 }
 
 func resourceDomainCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Domain creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Domain creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a Domain object and populate all attributes
 	object := vault.NewDomain(client)
 	object.Name = d.Get("name").(string)
@@ -336,17 +346,18 @@ func resourceDomainCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of Domain completed: %s", object.Name)
+	client.Logger.Infof("Creation of Domain completed: %s", object.Name)
 	return resourceDomainRead(d, m)
 }
 
 func resourceDomainUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Domain update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Domain update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewDomain(client)
 
 	object.ID = d.Id()
@@ -403,7 +414,6 @@ func resourceDomainUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating Domain attribute: %v", err)
 		}
-		//logger.Debugf("Updated attributes to: %+v", object)
 	}
 
 	// Deal with Set member
@@ -432,13 +442,14 @@ func resourceDomainUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Partial(false)
-	logger.Infof("Updating of Domain completed: %s", object.Name)
+	client.Logger.Infof("Updating of Domain completed: %s", object.Name)
 	return resourceDomainRead(d, m)
 }
 
 func resourceDomainDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Domain: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Domain: %s", ResourceIDString(d))
 
 	object := vault.NewDomain(client)
 	object.ID = d.Id()
@@ -454,7 +465,7 @@ func resourceDomainDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Domain completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Domain completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -114,8 +112,9 @@ func getDomainConfigurationSchema() map[string]*schema.Schema {
 }
 
 func resourceDomainConfigurationRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Domain Configuration: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Domain Configuration: %s", ResourceIDString(d))
 
 	// Create a Domain object and populate ID attribute
 	object := vault.NewDomain(client)
@@ -125,10 +124,12 @@ func resourceDomainConfigurationRead(d *schema.ResourceData, m interface{}) erro
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading Domain: %v", err)
 	}
-	//logger.Debugf("Domain from tenant: %v", object)
 
 	d.Set("administrative_account_id", object.AdminAccountID)
 	d.Set("administrator_display_name", object.AdministratorDisplayName)
@@ -154,18 +155,18 @@ func resourceDomainConfigurationRead(d *schema.ResourceData, m interface{}) erro
 		d.Set("assigned_zonerole", zrschema)
 	}
 
-	logger.Infof("Completed reading Domain Configuration: %s", object.Name)
+	client.Logger.Infof("Completed reading Domain Configuration: %s", object.Name)
 	return nil
 }
 
 func resourceDomainConfigurationCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Domain Configuration: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Domain Configuration: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a Domain object and populate all attributes
 	object := vault.NewDomain(client)
 	object.ID = d.Get("domain_id").(string)
@@ -199,17 +200,18 @@ func resourceDomainConfigurationCreate(d *schema.ResourceData, m interface{}) er
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Setting of Domain Configuration completed: %s", object.Name)
+	client.Logger.Infof("Setting of Domain Configuration completed: %s", object.Name)
 	return resourceDomainConfigurationRead(d, m)
 }
 
 func resourceDomainConfigurationUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Domain Configuration update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Domain Configuration update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewDomain(client)
 	object.ID = d.Get("domain_id").(string)
 	err := object.Read()
@@ -242,13 +244,14 @@ func resourceDomainConfigurationUpdate(d *schema.ResourceData, m interface{}) er
 	}
 
 	d.Partial(false)
-	logger.Infof("Updating of Domain Configuration completed: %s", object.Name)
+	client.Logger.Infof("Updating of Domain Configuration completed: %s", object.Name)
 	return resourceDomainConfigurationRead(d, m)
 }
 
 func resourceDomainConfigurationDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning removing of Domain Configuration: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning removing of Domain Configuration: %s", ResourceIDString(d))
 
 	object := vault.NewDomain(client)
 	object.ID = d.Get("domain_id").(string)
@@ -282,7 +285,7 @@ func resourceDomainConfigurationDelete(d *schema.ResourceData, m interface{}) er
 
 	d.SetId("")
 
-	logger.Infof("Removing of Domain Configuration completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Removing of Domain Configuration completed: %s", ResourceIDString(d))
 	return nil
 }
 
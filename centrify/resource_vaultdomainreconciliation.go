@@ -3,9 +3,7 @@ package centrify
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
-	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
@@ -84,8 +82,9 @@ func resourceDomainReconciliation() *schema.Resource {
 }
 
 func resourceDomainReconciliationRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Domain reconciliation settings: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Domain reconciliation settings: %s", ResourceIDString(d))
 
 	// Create a Domain object and populate ID attribute
 	object := vault.NewDomain(client)
@@ -95,10 +94,12 @@ func resourceDomainReconciliationRead(d *schema.ResourceData, m interface{}) err
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading Domain: %v", err)
 	}
-	//logger.Debugf("Domain from tenant: %v", object)
 
 	d.Set("administrative_account_id", object.AdminAccountID)
 	d.Set("administrator_display_name", object.AdministratorDisplayName)
@@ -110,18 +111,18 @@ func resourceDomainReconciliationRead(d *schema.ResourceData, m interface{}) err
 	d.Set("provisioning_admin_id", object.ProvisioningAdminID)
 	d.Set("reconciliation_account_name", object.ReconciliationAccountName)
 
-	logger.Infof("Completed reading Domain reconciliation settings: %s", object.Name)
+	client.Logger.Infof("Completed reading Domain reconciliation settings: %s", object.Name)
 	return nil
 }
 
 func resourceDomainReconciliationCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Domain reconciliation settings: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Domain reconciliation settings: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a Domain object and populate all attributes
 	object := vault.NewDomain(client)
 	object.ID = d.Get("domain_id").(string)
@@ -155,17 +156,18 @@ func resourceDomainReconciliationCreate(d *schema.ResourceData, m interface{}) e
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Setting of Domain reconciliation completed: %s", object.Name)
+	client.Logger.Infof("Setting of Domain reconciliation completed: %s", object.Name)
 	return resourceDomainReconciliationRead(d, m)
 }
 
 func resourceDomainReconciliationUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Domain reconciliation update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Domain reconciliation update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewDomain(client)
 	object.ID = d.Get("domain_id").(string)
 	err := object.Read()
@@ -198,13 +200,14 @@ func resourceDomainReconciliationUpdate(d *schema.ResourceData, m interface{}) e
 	}
 
 	d.Partial(false)
-	logger.Infof("Updating of Domain reconciliation completed: %s", object.Name)
+	client.Logger.Infof("Updating of Domain reconciliation completed: %s", object.Name)
 	return resourceDomainReconciliationRead(d, m)
 }
 
 func resourceDomainReconciliationDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning removing of Domain reconciliation settings: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning removing of Domain reconciliation settings: %s", ResourceIDString(d))
 
 	object := vault.NewDomain(client)
 	object.ID = d.Get("domain_id").(string)
@@ -235,7 +238,7 @@ func resourceDomainReconciliationDelete(d *schema.ResourceData, m interface{}) e
 
 	d.SetId("")
 
-	logger.Infof("Removing of Domain reconciliation setting completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Removing of Domain reconciliation setting completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -1,11 +1,12 @@
 package centrify
 
 import (
+	"encoding/base64"
 	"fmt"
-	"strings"
+	"io/ioutil"
+	"path/filepath"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/secrettype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -20,10 +21,11 @@ func resourceSecret_deprecated() *schema.Resource {
 		Delete: resourceSecretDelete,
 		Exists: resourceSecretExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("secret", "<folder path>/<name>", lookupSecretID),
 		},
 
 		Schema:             getSecretSchema(),
+		CustomizeDiff:      customizeSecretDiff,
 		DeprecationMessage: "resource centrifyvault_vaultsecret is deprecated will be removed in the future, use centrify_secret instead",
 	}
 }
@@ -36,13 +38,17 @@ func resourceSecret() *schema.Resource {
 		Delete: resourceSecretDelete,
 		Exists: resourceSecretExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("secret", "<folder path>/<name>", lookupSecretID),
 		},
 
-		Schema: getSecretSchema(),
+		Schema:        getSecretSchema(),
+		CustomizeDiff: customizeSecretDiff,
 	}
 }
 
+// secretFileUploadAttempts is how many times upload of secret file is resumed after a chunk fails
+const secretFileUploadAttempts = 3
+
 func getSecretSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"secret_name": {
@@ -62,7 +68,7 @@ func getSecretSchema() map[string]*schema.Schema {
 			Description: "Either Text or File",
 			ValidateFunc: validation.StringInSlice([]string{
 				secrettype.Text.String(),
-				//secrettype.File.String(),
+				secrettype.File.String(),
 			}, false),
 		},
 		"secret_text": {
@@ -71,6 +77,29 @@ func getSecretSchema() map[string]*schema.Schema {
 			Sensitive:   true,
 			Description: "Content of the secret",
 		},
+		"file_path": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			ConflictsWith: []string{"secret_text", "file_content_base64"},
+			Description:   "Path of the file uploaded as content of File secret",
+		},
+		"file_content_base64": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Sensitive:     true,
+			ConflictsWith: []string{"secret_text"},
+			Description:   "Base64 encoded content of File secret",
+		},
+		"file_name": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Name of the secret file. Defaults to base name of file_path",
+		},
+		"file_hash": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SHA-256 hash of the secret file content, used to detect content changes",
+		},
 		"folder_id": {
 			Type:        schema.TypeString,
 			Optional:    true,
@@ -110,28 +139,57 @@ func getSecretSchema() map[string]*schema.Schema {
 	}
 }
 
+func customizeSecretDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.NewValueKnown("type") || !d.NewValueKnown("file_path") || !d.NewValueKnown("file_content_base64") || !d.NewValueKnown("file_name") {
+		return nil
+	}
+	_, hasPath := d.GetOk("file_path")
+	_, hasContent := d.GetOk("file_content_base64")
+	if d.Get("type").(string) != secrettype.File.String() {
+		if hasPath || hasContent {
+			return fmt.Errorf(" file_path and file_content_base64 can only be set for File secret")
+		}
+		return nil
+	}
+	if !hasPath && !hasContent {
+		return fmt.Errorf(" Either file_path or file_content_base64 must be set for File secret")
+	}
+
+	// Content of the file may change while file_path stays the same, so hash of content is compared instead
+	upload, err := getSecretFileUpload(d.GetOk)
+	if err != nil {
+		return err
+	}
+	if d.Get("file_hash").(string) != upload.Hash {
+		return d.SetNew("file_hash", upload.Hash)
+	}
+	return nil
+}
+
 func resourceSecretExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Secret exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Secret exist: %s", ResourceIDString(d))
 
 	object := vault.NewSecret(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Secret exists in tenant: %s", object.ID)
+	client.Logger.Infof("Secret exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceSecretRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Secret: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Secret: %s", ResourceIDString(d))
 
 	// Create a NewSecret object and populate ID attribute
 	object := vault.NewSecret(client)
@@ -141,15 +199,17 @@ func resourceSecretRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading Secret: %v", err)
 	}
-	//logger.Debugf("Secret from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceSecretRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceSecretRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -161,24 +221,42 @@ func resourceSecretRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading Secret: %s", object.Name)
+	clearAbsentAttributes(d, schemamap, "challenge_rule")
+	// Secret file content can't be read back without downloading it, so only name is compared
+	if _, ok := d.GetOk("file_name"); ok && object.SecretFileName != "" {
+		d.Set("file_name", object.SecretFileName)
+	}
+
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+	if err := readSets(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading Secret: %s", object.Name)
 	return nil
 }
 
 func resourceSecretCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Secret creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Secret creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a Secret object and populate all attributes
 	object := vault.NewSecret(client)
 	err := getCreateSecretData(d, object)
 	if err != nil {
 		return err
 	}
+	if object.Type == secrettype.File.String() {
+		if err := uploadSecretFile(client, d, object); err != nil {
+			return err
+		}
+	}
 	resp, err := object.Create()
 	if err != nil {
 		return fmt.Errorf(" Error creating Secret: %v", err)
@@ -223,17 +301,18 @@ func resourceSecretCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of Secret completed: %s", object.SecretName)
+	client.Logger.Infof("Creation of Secret completed: %s", object.SecretName)
 	return resourceSecretRead(d, m)
 }
 
 func resourceSecretUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Secret update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Secret update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewSecret(client)
 	object.ID = d.Id()
 	err := getUpateGetSecretData(d, object)
@@ -241,9 +320,16 @@ func resourceSecretUpdate(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	// Upload new file content before it is referred by the update
+	if object.Type == secrettype.File.String() && d.HasChanges("file_hash", "file_name") {
+		if err := uploadSecretFile(client, d, object); err != nil {
+			return err
+		}
+	}
+
 	// Deal with normal attribute changes first
 	if d.HasChanges("secret_name", "description", "secret_text", "folder_id", "type", "parent_path", "default_profile_id", "challenge_rule",
-		"workflow_enabled", "workflow_approver") {
+		"workflow_enabled", "workflow_approver", "file_hash", "file_name") {
 		// Special handling for default_profile_id. Whenever there is change, default_profile_id must be set otherwise default profile setting will be removed
 		if v, ok := d.GetOk("default_profile_id"); ok && !d.HasChange("default_profile_id") {
 			object.DataVaultDefaultProfile = v.(string)
@@ -253,7 +339,7 @@ func resourceSecretUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating Secret attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("sets") {
@@ -312,13 +398,14 @@ func resourceSecretUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of Secret completed: %s", object.Name)
+	client.Logger.Infof("Updating of Secret completed: %s", object.Name)
 	return resourceSecretRead(d, m)
 }
 
 func resourceSecretDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Secret: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Secret: %s", ResourceIDString(d))
 
 	object := vault.NewSecret(client)
 	object.ID = d.Id()
@@ -349,7 +436,7 @@ func resourceSecretDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Secret completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Secret completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -410,3 +497,61 @@ func getUpateGetSecretData(d *schema.ResourceData, object *vault.Secret) error {
 
 	return nil
 }
+
+// getSecretFileUpload reads content of File secret from file_path or file_content_base64
+func getSecretFileUpload(getOk func(string) (interface{}, bool)) (*vault.SecretFileUpload, error) {
+	var name string
+	var content []byte
+	var err error
+	if v, ok := getOk("file_path"); ok {
+		name = filepath.Base(v.(string))
+		content, err = ioutil.ReadFile(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf(" Error reading secret file: %v", err)
+		}
+	} else if v, ok := getOk("file_content_base64"); ok {
+		content, err = base64.StdEncoding.DecodeString(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf(" Error decoding file_content_base64: %v", err)
+		}
+	}
+	if v, ok := getOk("file_name"); ok {
+		name = v.(string)
+	}
+	if name == "" {
+		return nil, fmt.Errorf(" file_name must be set when content of secret file is given by file_content_base64")
+	}
+
+	upload, err := vault.NewSecretFileUpload(name, content)
+	if err != nil {
+		return nil, fmt.Errorf(" Schema setting error: %v", err)
+	}
+	return upload, nil
+}
+
+// uploadSecretFile uploads content of File secret so that object refers to it. Upload is resumed from the failed chunk
+func uploadSecretFile(client *restapi.RestClient, d *schema.ResourceData, object *vault.Secret) error {
+	upload, err := getSecretFileUpload(d.GetOk)
+	if err != nil {
+		return err
+	}
+	if planned := d.Get("file_hash").(string); planned != "" && planned != upload.Hash {
+		return fmt.Errorf(" Content of secret file %s changed after plan was made", upload.FileName)
+	}
+
+	object.SecretName = d.Get("secret_name").(string)
+	for attempt := 1; ; attempt++ {
+		err = object.UploadSecretFile(upload)
+		if err == nil || attempt >= secretFileUploadAttempts || client.Context().Err() != nil {
+			break
+		}
+		client.Logger.Infof("Upload of secret file %s stopped at chunk %d of %d: %v. Resuming", upload.FileName, upload.Uploaded+1, upload.Chunks(), err)
+	}
+	if err != nil {
+		return fmt.Errorf(" Error uploading secret file: %v", err)
+	}
+	d.Set("file_hash", upload.Hash)
+
+	client.Logger.Infof("Uploaded secret file %s of %d bytes", upload.FileName, len(upload.Content))
+	return nil
+}
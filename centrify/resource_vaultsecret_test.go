@@ -0,0 +1,132 @@
+package centrify
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestSecretFileUpload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret-file-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keystore.jks")
+	if err := ioutil.WriteFile(path, []byte("keystore"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var body []byte
+	meta, server := testTenant(t, map[string]restapitest.Handler{
+		"/ServerManage/RequestSecretUploadUrl": restapitest.Result(map[string]interface{}{"FilePath": "uploads/1"}),
+		"/ServerManage/UploadSecretFileInChunks": func(r *restapitest.Request) interface{} {
+			body = r.Body
+			return true
+		},
+	})
+	defer server.Close()
+	client := meta.client
+
+	cases := []struct {
+		name     string
+		config   map[string]interface{}
+		fileName string
+		err      string
+	}{
+		{"file path", map[string]interface{}{"file_path": path}, "keystore.jks", ""},
+		{"renamed file", map[string]interface{}{"file_path": path, "file_name": "store.jks"}, "store.jks", ""},
+		{"base64 content", map[string]interface{}{"file_content_base64": base64.StdEncoding.EncodeToString([]byte("keystore")), "file_name": "cert.pem"}, "cert.pem", ""},
+		{"base64 content without name", map[string]interface{}{"file_content_base64": base64.StdEncoding.EncodeToString([]byte("keystore"))}, "", "file_name must be set"},
+		{"missing file", map[string]interface{}{"file_path": filepath.Join(dir, "missing")}, "", "Error reading secret file"},
+	}
+	for _, tc := range cases {
+		tc.config["secret_name"] = "Keystore"
+		tc.config["type"] = "File"
+		d := schema.TestResourceDataRaw(t, resourceSecret().Schema, tc.config)
+		object := vault.NewSecret(client)
+		err := uploadSecretFile(client, d, object)
+		if tc.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("%s: expected error %q, got %v", tc.name, tc.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if string(body) != "keystore" || object.SecretFileName != tc.fileName || object.SecretFilePath != "uploads/1" {
+			t.Errorf("%s: unexpected upload %q of secret %+v", tc.name, body, object)
+		}
+		if d.Get("file_hash").(string) != vault.SecretFileHash([]byte("keystore")) {
+			t.Errorf("%s: expected file_hash to be set, got %q", tc.name, d.Get("file_hash"))
+		}
+	}
+}
+
+func TestSecretFileUploadResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secret-file-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "backup.tar")
+	content := bytes.Repeat([]byte("0123456789"), vault.DefaultSecretFileChunkSize/4)
+	if err := ioutil.WriteFile(path, content, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	// Second chunk keeps failing until the client's retries are used up, so the upload has to be resumed
+	var requests int
+	sent := make(map[int]int)
+	chunks := make(map[int][]byte)
+	meta, server := testTenant(t, map[string]restapitest.Handler{
+		"/ServerManage/RequestSecretUploadUrl": func(r *restapitest.Request) interface{} {
+			requests++
+			return map[string]interface{}{"FilePath": "uploads/1"}
+		},
+		"/ServerManage/UploadSecretFileInChunks": func(r *restapitest.Request) interface{} {
+			i, _ := strconv.Atoi(r.URL.Query().Get("ChunkIndex"))
+			if sent[i]++; i == 1 && sent[i] <= 3 {
+				return restapitest.Status(http.StatusServiceUnavailable)
+			}
+			chunks[i] = r.Body
+			return true
+		},
+	})
+	defer server.Close()
+	client := meta.client
+	client.RetryPolicy = &restapi.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	d := schema.TestResourceDataRaw(t, resourceSecret().Schema, map[string]interface{}{
+		"secret_name": "Backup",
+		"type":        "File",
+		"file_path":   path,
+	})
+	object := vault.NewSecret(client)
+	if err := uploadSecretFile(client, d, object); err != nil {
+		t.Fatal(err)
+	}
+
+	if requests != 1 {
+		t.Errorf("expected upload location to be requested once, got %d", requests)
+	}
+	if sent[0] != 1 || sent[1] != 4 || sent[2] != 1 {
+		t.Errorf("expected only the failed chunk to be sent again, got %v", sent)
+	}
+	if received := bytes.Join([][]byte{chunks[0], chunks[1], chunks[2]}, nil); !bytes.Equal(received, content) {
+		t.Errorf("uploaded content doesn't match")
+	}
+}
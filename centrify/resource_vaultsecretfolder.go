@@ -2,9 +2,7 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -18,7 +16,7 @@ func resourceSecretFolder_deprecated() *schema.Resource {
 		Delete: resourceSecretFolderDelete,
 		Exists: resourceSecretFolderExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("secretfolder", "<path>", lookupSecretFolderID),
 		},
 
 		Schema:             getSecretFolderSchema(),
@@ -34,7 +32,7 @@ func resourceSecretFolder() *schema.Resource {
 		Delete: resourceSecretFolderDelete,
 		Exists: resourceSecretFolderExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("secretfolder", "<path>", lookupSecretFolderID),
 		},
 
 		Schema: getSecretFolderSchema(),
@@ -76,27 +74,29 @@ func getSecretFolderSchema() map[string]*schema.Schema {
 }
 
 func resourceSecretFolderExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking SecretFolder exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking SecretFolder exist: %s", ResourceIDString(d))
 
 	object := vault.NewSecretFolder(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("SecretFolder exists in tenant: %s", object.ID)
+	client.Logger.Infof("SecretFolder exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceSecretFolderRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading SecretFolder: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading SecretFolder: %s", ResourceIDString(d))
 
 	// Create a NewSecretFolder object and populate ID attribute
 	object := vault.NewSecretFolder(client)
@@ -106,31 +106,44 @@ func resourceSecretFolderRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading SecretFolder: %v", err)
 	}
-	//logger.Debugf("SecretFolder from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceSecretFolderRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceSecretFolderRead(): %+v", schemamap)
 	for k, v := range schemamap {
-		d.Set(k, v)
+		switch k {
+		case "challenge_rule":
+			d.Set(k, v.(map[string]interface{})["rule"])
+		default:
+			d.Set(k, v)
+		}
 	}
 
-	logger.Infof("Completed reading SecretFolder: %s", object.Name)
+	clearAbsentAttributes(d, schemamap, "challenge_rule")
+
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading SecretFolder: %s", object.Name)
 	return nil
 }
 
 func resourceSecretFolderCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning SecretFolder creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning SecretFolder creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a SecretFolder object and populate all attributes
 	object := vault.NewSecretFolder(client)
 	err := getCreateSecretFolderData(d, object)
@@ -182,17 +195,18 @@ func resourceSecretFolderCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of SecretFolder completed: %s", object.Name)
+	client.Logger.Infof("Creation of SecretFolder completed: %s", object.Name)
 	return resourceSecretFolderRead(d, m)
 }
 
 func resourceSecretFolderUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning SecretFolder update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning SecretFolder update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewSecretFolder(client)
 	object.ID = d.Id()
 	err := getUpdateSecretFolderData(d, object)
@@ -210,7 +224,7 @@ func resourceSecretFolderUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating SecretFolder attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("parent_id") {
@@ -284,13 +298,14 @@ func resourceSecretFolderUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of SecretFolder completed: %s", object.Name)
+	client.Logger.Infof("Updating of SecretFolder completed: %s", object.Name)
 	return resourceSecretFolderRead(d, m)
 }
 
 func resourceSecretFolderDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of SecretFolder: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of SecretFolder: %s", ResourceIDString(d))
 
 	object := vault.NewSecretFolder(client)
 	object.ID = d.Id()
@@ -321,7 +336,7 @@ func resourceSecretFolderDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of SecretFolder completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of SecretFolder completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -6,7 +6,6 @@ import (
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/computerclass"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/managementmode"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -21,7 +20,7 @@ func resourceSystem_deprecated() *schema.Resource {
 		Delete: resourceSystemDelete,
 		Exists: resourceSystemExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("system", "<name>", lookupSystemID),
 		},
 
 		Schema:             getSystemSchema(),
@@ -37,7 +36,7 @@ func resourceSystem() *schema.Resource {
 		Delete: resourceSystemDelete,
 		Exists: resourceSystemExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importStateByName("system", "<name>", lookupSystemID),
 		},
 
 		Schema: getSystemSchema(),
@@ -345,27 +344,29 @@ func getSystemSchema() map[string]*schema.Schema {
 }
 
 func resourceSystemExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking System exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking System exist: %s", ResourceIDString(d))
 
 	object := vault.NewSystem(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("System exists in tenant: %s", object.ID)
+	client.Logger.Infof("System exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceSystemRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading System: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading System: %s", ResourceIDString(d))
 
 	// Create a System object and populate ID attribute
 	object := vault.NewSystem(client)
@@ -375,16 +376,18 @@ func resourceSystemRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf(" Error reading System: %v", err)
 	}
-	//logger.Debugf("System from tenant: %v", object)
 
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceSystemRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceSystemRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "connector_list":
@@ -415,18 +418,28 @@ func resourceSystemRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading System: %s", object.Name)
+	clearAbsentAttributes(d, schemamap, "challenge_rule", "privilege_elevation_rule")
+
+	object.ResolveValidPermissions()
+	if err := readPermissions(d, object); err != nil {
+		return err
+	}
+	if err := readSets(d, object); err != nil {
+		return err
+	}
+
+	client.Logger.Infof("Completed reading System: %s", object.Name)
 	return nil
 }
 
 func resourceSystemCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning System creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning System creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a System object and populate all attributes
 	object := vault.NewSystem(client)
 	err := createUpateGetSystemData(d, object)
@@ -450,7 +463,7 @@ func resourceSystemCreate(d *schema.ResourceData, m interface{}) error {
 	// 2nd step to update system login profile and connectors
 	// Create API call doesn't set system login profile and connectors so need to run update again
 	if object.LoginDefaultProfile != "" || object.ProxyCollectionList != "" {
-		logger.Debugf("Update login profile and connector for System creation: %s", ResourceIDString(d))
+		client.Logger.Debugf("Update login profile and connector for System creation: %s", ResourceIDString(d))
 		resp, err := object.Update()
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating System attribute: %v", err)
@@ -475,17 +488,18 @@ func resourceSystemCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of System completed: %s", object.Name)
+	client.Logger.Infof("Creation of System completed: %s", object.Name)
 	return resourceSystemRead(d, m)
 }
 
 func resourceSystemUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning System update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning System update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewSystem(client)
 
 	object.ID = d.Id()
@@ -516,7 +530,7 @@ func resourceSystemUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf(" Error updating System attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %+v", object)
+		client.Logger.Debugf("Updated attributes to: %+v", object)
 	}
 
 	// Deal with Set member
@@ -575,13 +589,14 @@ func resourceSystemUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	d.Partial(false)
-	logger.Infof("Updating of System completed: %s", object.Name)
+	client.Logger.Infof("Updating of System completed: %s", object.Name)
 	return resourceSystemRead(d, m)
 }
 
 func resourceSystemDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of System: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of System: %s", ResourceIDString(d))
 
 	object := vault.NewSystem(client)
 	object.ID = d.Id()
@@ -597,7 +612,7 @@ func resourceSystemDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of System completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of System completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -2,14 +2,12 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/webapp/accountmapping"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/webapp/generic/applicationtemplate"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
@@ -207,27 +205,29 @@ func getGenericWebAppSchema() map[string]*schema.Schema {
 }
 
 func resourceGenericWebAppExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Generic WebApp exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Generic WebApp exist: %s", ResourceIDString(d))
 
 	object := vault.NewGenericWebApp(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Generic WebApp exists in tenant: %s", object.ID)
+	client.Logger.Infof("Generic WebApp exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceGenericWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Generic WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Generic WebApp: %s", ResourceIDString(d))
 
 	// Create a NewWebpp object and populate ID attribute
 	object := vault.NewGenericWebApp(client)
@@ -237,15 +237,17 @@ func resourceGenericWebAppRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading Generic WebApp: %v", err)
 	}
-	//logger.Debugf("WebApp from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceGenericWebAppRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceGenericWebAppRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -274,18 +276,18 @@ func resourceGenericWebAppRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading Generic WebApp: %s", object.Name)
+	client.Logger.Infof("Completed reading Generic WebApp: %s", object.Name)
 	return nil
 }
 
 func resourceGenericWebAppCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Generic WebApp creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Generic WebApp creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a WebApp object
 	object := vault.NewGenericWebApp(client)
 	object.TemplateName = d.Get("template_name").(string)
@@ -334,17 +336,18 @@ func resourceGenericWebAppCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of Generic WebApp completed: %s", object.Name)
+	client.Logger.Infof("Creation of Generic WebApp completed: %s", object.Name)
 	return resourceGenericWebAppRead(d, m)
 }
 
 func resourceGenericWebAppUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Generic WebApp update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Generic WebApp update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewGenericWebApp(client)
 	object.ID = d.Id()
 
@@ -366,7 +369,7 @@ func resourceGenericWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf("error updating Generic WebApp attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("sets") {
@@ -425,13 +428,14 @@ func resourceGenericWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of Generic WebApp completed: %s", object.Name)
+	client.Logger.Infof("Updating of Generic WebApp completed: %s", object.Name)
 	return resourceGenericWebAppRead(d, m)
 }
 
 func resourceGenericWebAppDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Generic WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Generic WebApp: %s", ResourceIDString(d))
 
 	object := vault.NewGenericWebApp(client)
 	object.ID = d.Id()
@@ -447,7 +451,7 @@ func resourceGenericWebAppDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Generic WebApp completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Generic WebApp completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -10,7 +10,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
@@ -229,27 +228,29 @@ func getOauthWebAppSchema() map[string]*schema.Schema {
 }
 
 func resourceOauthWebAppExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Oauth WebApp exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Oauth WebApp exist: %s", ResourceIDString(d))
 
 	object := vault.NewOauthWebApp(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Oauth WebApp exists in tenant: %s", object.ID)
+	client.Logger.Infof("Oauth WebApp exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceOauthWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Oauth WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Oauth WebApp: %s", ResourceIDString(d))
 
 	// Create a NewWebpp object and populate ID attribute
 	object := vault.NewOauthWebApp(client)
@@ -259,15 +260,17 @@ func resourceOauthWebAppRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading Oauth WebApp: %v", err)
 	}
-	//logger.Debugf("WebApp from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceOauthWebAppRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceOauthWebAppRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "oauth_profile":
@@ -288,18 +291,18 @@ func resourceOauthWebAppRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading Oauth WebApp: %s", object.Name)
+	client.Logger.Infof("Completed reading Oauth WebApp: %s", object.Name)
 	return nil
 }
 
 func resourceOauthWebAppCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Oauth WebApp creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Oauth WebApp creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a WebApp object
 	object := vault.NewOauthWebApp(client)
 	object.TemplateName = d.Get("template_name").(string)
@@ -348,17 +351,18 @@ func resourceOauthWebAppCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of Oauth WebApp completed: %s", object.Name)
+	client.Logger.Infof("Creation of Oauth WebApp completed: %s", object.Name)
 	return resourceOauthWebAppRead(d, m)
 }
 
 func resourceOauthWebAppUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Oauth WebApp update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Oauth WebApp update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewOauthWebApp(client)
 	object.ID = d.Id()
 	err := createUpateGetOauthWebAppData(d, object)
@@ -372,7 +376,7 @@ func resourceOauthWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf("error updating Oauth WebApp attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("sets") {
@@ -431,13 +435,14 @@ func resourceOauthWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of Oauth WebApp completed: %s", object.Name)
+	client.Logger.Infof("Updating of Oauth WebApp completed: %s", object.Name)
 	return resourceOauthWebAppRead(d, m)
 }
 
 func resourceOauthWebAppDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Oauth WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Oauth WebApp: %s", ResourceIDString(d))
 
 	object := vault.NewOauthWebApp(client)
 	object.ID = d.Id()
@@ -453,7 +458,7 @@ func resourceOauthWebAppDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Oauth WebApp completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Oauth WebApp completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -2,14 +2,12 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/webapp/accountmapping"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/webapp/oidc/applicationtemplate"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
@@ -214,27 +212,29 @@ func getOidcWebAppSchema() map[string]*schema.Schema {
 }
 
 func resourceOidcWebAppExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking Oidc WebApp exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking Oidc WebApp exist: %s", ResourceIDString(d))
 
 	object := vault.NewOidcWebApp(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("Oidc WebApp exists in tenant: %s", object.ID)
+	client.Logger.Infof("Oidc WebApp exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceOidcWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading Oidc WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading Oidc WebApp: %s", ResourceIDString(d))
 
 	// Create a NewWebpp object and populate ID attribute
 	object := vault.NewOidcWebApp(client)
@@ -244,15 +244,17 @@ func resourceOidcWebAppRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading Oidc WebApp: %v", err)
 	}
-	//logger.Debugf("WebApp from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceOidcWebAppRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceOidcWebAppRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "oauth_profile":
@@ -273,18 +275,18 @@ func resourceOidcWebAppRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading Oidc WebApp: %s", object.Name)
+	client.Logger.Infof("Completed reading Oidc WebApp: %s", object.Name)
 	return nil
 }
 
 func resourceOidcWebAppCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Oidc WebApp creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning Oidc WebApp creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a WebApp object
 	object := vault.NewOidcWebApp(client)
 	object.TemplateName = d.Get("template_name").(string)
@@ -333,17 +335,18 @@ func resourceOidcWebAppCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of Oidc WebApp completed: %s", object.Name)
+	client.Logger.Infof("Creation of Oidc WebApp completed: %s", object.Name)
 	return resourceOidcWebAppRead(d, m)
 }
 
 func resourceOidcWebAppUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning Oidc WebApp update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning Oidc WebApp update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewOidcWebApp(client)
 	object.ID = d.Id()
 	// ClientId is gnerated value and must be supplied for update action,
@@ -374,7 +377,7 @@ func resourceOidcWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf("error updating Oauth WebApp attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("sets") {
@@ -433,13 +436,14 @@ func resourceOidcWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of Oidc WebApp completed: %s", object.Name)
+	client.Logger.Infof("Updating of Oidc WebApp completed: %s", object.Name)
 	return resourceOidcWebAppRead(d, m)
 }
 
 func resourceOidcWebAppDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of Oidc WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of Oidc WebApp: %s", ResourceIDString(d))
 
 	object := vault.NewOidcWebApp(client)
 	object.ID = d.Id()
@@ -455,7 +459,7 @@ func resourceOidcWebAppDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of Oidc WebApp completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of Oidc WebApp completed: %s", ResourceIDString(d))
 	return nil
 }
 
@@ -2,7 +2,6 @@ package centrify
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/webapp/accountmapping"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/webapp/saml/applicationtemplate"
@@ -10,7 +9,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
@@ -318,27 +316,29 @@ func samlAttributeSuppress(k, old, new string, d *schema.ResourceData) bool {
 }
 
 func resourceSamlWebAppExists(d *schema.ResourceData, m interface{}) (bool, error) {
-	logger.Infof("Checking SAML WebApp exist: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Checking SAML WebApp exist: %s", ResourceIDString(d))
 
 	object := vault.NewSamlWebApp(client)
 	object.ID = d.Id()
 	err := object.Read()
 
 	if err != nil {
-		if strings.Contains(err.Error(), "not exist") || strings.Contains(err.Error(), "not found") {
+		if restapi.IsNotFound(err) {
 			return false, nil
 		}
 		return false, err
 	}
 
-	logger.Infof("SAML WebApp exists in tenant: %s", object.ID)
+	client.Logger.Infof("SAML WebApp exists in tenant: %s", object.ID)
 	return true, nil
 }
 
 func resourceSamlWebAppRead(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Reading SAML WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutRead)
+	defer cancel()
+	client.Logger.Infof("Reading SAML WebApp: %s", ResourceIDString(d))
 
 	// Create a NewWebpp object and populate ID attribute
 	object := vault.NewSamlWebApp(client)
@@ -348,15 +348,17 @@ func resourceSamlWebAppRead(d *schema.ResourceData, m interface{}) error {
 	// If the resource does not exist, inform Terraform. We want to immediately
 	// return here to prevent further processing.
 	if err != nil {
+		if handleNotFound(d, client, err) {
+			return nil
+		}
 		d.SetId("")
 		return fmt.Errorf("error reading SAML WebApp: %v", err)
 	}
-	//logger.Debugf("WebApp from tenant: %+v", object)
 	schemamap, err := vault.GenerateSchemaMap(object)
 	if err != nil {
 		return err
 	}
-	logger.Debugf("Generated Map for resourceSamlWebAppRead(): %+v", schemamap)
+	client.Logger.Debugf("Generated Map for resourceSamlWebAppRead(): %+v", schemamap)
 	for k, v := range schemamap {
 		switch k {
 		case "challenge_rule":
@@ -375,18 +377,18 @@ func resourceSamlWebAppRead(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	logger.Infof("Completed reading SAML WebApp: %s", object.Name)
+	client.Logger.Infof("Completed reading SAML WebApp: %s", object.Name)
 	return nil
 }
 
 func resourceSamlWebAppCreate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning SAML WebApp creation: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutCreate)
+	defer cancel()
+	client.Logger.Infof("Beginning SAML WebApp creation: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
-
 	// Create a WebApp object
 	object := vault.NewSamlWebApp(client)
 	object.TemplateName = d.Get("template_name").(string)
@@ -435,17 +437,18 @@ func resourceSamlWebAppCreate(d *schema.ResourceData, m interface{}) error {
 
 	// Creation completed
 	d.Partial(false)
-	logger.Infof("Creation of SAML WebApp completed: %s", object.Name)
+	client.Logger.Infof("Creation of SAML WebApp completed: %s", object.Name)
 	return resourceSamlWebAppRead(d, m)
 }
 
 func resourceSamlWebAppUpdate(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning SAML WebApp update: %s", ResourceIDString(d))
+	client, cancel := getRestClient(d, m, schema.TimeoutUpdate)
+	defer cancel()
+	client.Logger.Infof("Beginning SAML WebApp update: %s", ResourceIDString(d))
 
 	// Enable partial state mode
 	d.Partial(true)
 
-	client := m.(*restapi.RestClient)
 	object := vault.NewSamlWebApp(client)
 	object.ID = d.Id()
 	err := createUpateGetSamlWebAppData(d, object)
@@ -462,7 +465,7 @@ func resourceSamlWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 		if err != nil || !resp.Success {
 			return fmt.Errorf("error updating SAML WebApp attribute: %v", err)
 		}
-		logger.Debugf("Updated attributes to: %v", object)
+		client.Logger.Debugf("Updated attributes to: %v", object)
 	}
 
 	if d.HasChange("sets") {
@@ -521,13 +524,14 @@ func resourceSamlWebAppUpdate(d *schema.ResourceData, m interface{}) error {
 
 	// We succeeded, disable partial mode. This causes Terraform to save all fields again.
 	d.Partial(false)
-	logger.Infof("Updating of WebApp completed: %s", object.Name)
+	client.Logger.Infof("Updating of WebApp completed: %s", object.Name)
 	return resourceSamlWebAppRead(d, m)
 }
 
 func resourceSamlWebAppDelete(d *schema.ResourceData, m interface{}) error {
-	logger.Infof("Beginning deletion of SAML WebApp: %s", ResourceIDString(d))
-	client := m.(*restapi.RestClient)
+	client, cancel := getRestClient(d, m, schema.TimeoutDelete)
+	defer cancel()
+	client.Logger.Infof("Beginning deletion of SAML WebApp: %s", ResourceIDString(d))
 
 	object := vault.NewSamlWebApp(client)
 	object.ID = d.Id()
@@ -543,7 +547,7 @@ func resourceSamlWebAppDelete(d *schema.ResourceData, m interface{}) error {
 		d.SetId("")
 	}
 
-	logger.Infof("Deletion of SAML WebApp completed: %s", ResourceIDString(d))
+	client.Logger.Infof("Deletion of SAML WebApp completed: %s", ResourceIDString(d))
 	return nil
 }
 
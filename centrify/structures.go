@@ -7,8 +7,8 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/centrify/internal/hashcode"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
@@ -27,6 +27,17 @@ func ResourceIDString(d ResourceIDStringInterface) string {
 	return fmt.Sprintf("(ID = %s)", id)
 }
 
+// handleNotFound removes resource from state if err indicates that the object no longer exists in tenant.
+// Returns true if err is handled that way
+func handleNotFound(d *schema.ResourceData, client *restapi.RestClient, err error) bool {
+	if !restapi.IsNotFound(err) {
+		return false
+	}
+	client.Logger.Infof("Object %s no longer exists in tenant, removing it from state: %v", ResourceIDString(d), err)
+	d.SetId("")
+	return true
+}
+
 // flattenTypeListToSlice converts simple schema.TypeList to slices
 func flattenTypeListToSlice(i interface{}) []string {
 	var lstr []string
@@ -282,27 +293,20 @@ func expandRoleMembers(v interface{}) []vault.RoleMember {
 		member.MemberType = p.(map[string]interface{})["type"].(string)
 		members = append(members, member)
 	}
-	logger.Debugf("Role members: %+v", members)
-
 	return members
 }
 
 func expandPermissions(v interface{}, valid map[string]string, validate bool) ([]vault.Permission, error) {
 	m := v.(*schema.Set).List()
+	rights := vault.LookupRights(valid)
 	var permissions []vault.Permission
 	if m != nil {
 		for _, v := range m {
-			// Validate given list of permissions against a valid map
-			existing := v.(map[string]interface{})["rights"].(*schema.Set)
-			var converted []string
-			for _, r := range existing.List() {
-				if valid[r.(string)] != "" {
-					converted = append(converted, valid[r.(string)])
-				} else {
-					if validate {
-						return nil, fmt.Errorf("for %s, %v can only contain %v", v.(map[string]interface{})["principal_name"].(string), existing.List(), valid)
-					}
-				}
+			// Validate given list of permissions against a valid map. Without validation, unknown rights are passed as they are
+			// which is only the case for permissions being removed whose rights are replaced by None
+			converted, err := rights.ToAPI(flattenSchemaSetToStringSlice(v.(map[string]interface{})["rights"]))
+			if err != nil && validate {
+				return nil, fmt.Errorf("for %s, %v", v.(map[string]interface{})["principal_name"].(string), err)
 			}
 			// Convert map to Permission object
 			permission := vault.Permission{
@@ -317,6 +321,97 @@ func expandPermissions(v interface{}, valid map[string]string, validate bool) ([
 	return permissions, nil
 }
 
+// flattenPermissions converts permissions read from tenant into permission schema. Rights are converted back to names
+// used in configuration and unknown rights are kept so that they show up as difference. Principal name, type and
+// spelling of rights of existing permission with the same principal ID are kept
+func flattenPermissions(permissions []vault.Permission, rights *vault.RightsMap, existing *schema.Set) []interface{} {
+	known := make(map[string]map[string]interface{})
+	if existing != nil {
+		for _, v := range existing.List() {
+			known[v.(map[string]interface{})["principal_id"].(string)] = v.(map[string]interface{})
+		}
+	}
+
+	var out []interface{}
+	for _, p := range permissions {
+		names, _ := rights.FromAPI(p.RightList)
+		permission := map[string]interface{}{
+			"principal_id":   p.PrincipalID,
+			"principal_name": p.PrincipalName,
+			"principal_type": p.PrincipalType,
+			"rights":         flattenStringSliceToSet(names),
+		}
+		if k, ok := known[p.PrincipalID]; ok {
+			permission["principal_name"] = k["principal_name"]
+			if strings.EqualFold(k["principal_type"].(string), p.PrincipalType) {
+				permission["principal_type"] = k["principal_type"]
+			}
+			// Rights are case insensitive so keep spelling of existing rights
+			spelling := make(map[string]string)
+			for _, r := range flattenSchemaSetToStringSlice(k["rights"]) {
+				spelling[strings.ToLower(r)] = r
+			}
+			for i, r := range names {
+				if v, ok := spelling[strings.ToLower(r)]; ok {
+					names[i] = v
+				}
+			}
+			permission["rights"] = flattenStringSliceToSet(names)
+		}
+		out = append(out, permission)
+	}
+	return out
+}
+
+// readPermissions fetches object permissions from tenant and stores them in permission attribute. Permissions of objects
+// without permission attribute are managed elsewhere, so they are left out and aren't removed on update
+func readPermissions(d *schema.ResourceData, object interface {
+	GetPermissions() ([]vault.Permission, error)
+	Rights() *vault.RightsMap
+}) error {
+	existing, _ := d.Get("permission").(*schema.Set)
+	if existing == nil || existing.Len() == 0 {
+		return nil
+	}
+	permissions, err := object.GetPermissions()
+	if err != nil {
+		return fmt.Errorf(" Error reading permissions: %v", err)
+	}
+	return d.Set("permission", flattenPermissions(permissions, object.Rights(), existing))
+}
+
+// readSets fetches manual sets that object is member of from tenant and keeps the ones already in sets attribute.
+// Membership managed elsewhere, e.g. by centrify_set_membership, is left out so that only removal from sets shows up as difference
+func readSets(d *schema.ResourceData, object interface {
+	GetSets() ([]string, error)
+}) error {
+	managed, _ := d.Get("sets").(*schema.Set)
+	if managed == nil || managed.Len() == 0 {
+		return nil
+	}
+	sets, err := object.GetSets()
+	if err != nil {
+		return fmt.Errorf(" Error reading set membership: %v", err)
+	}
+	var kept []string
+	for _, v := range sets {
+		if managed.Contains(v) {
+			kept = append(kept, v)
+		}
+	}
+	return d.Set("sets", flattenStringSliceToSet(kept))
+}
+
+// clearAbsentAttributes empties attributes missing from schema map. Rules removed in tenant are omitted from object
+// instead of being returned as empty value so they would otherwise stay in state
+func clearAbsentAttributes(d *schema.ResourceData, schemamap map[string]interface{}, keys ...string) {
+	for _, k := range keys {
+		if _, ok := schemamap[k]; !ok {
+			d.Set(k, nil)
+		}
+	}
+}
+
 func expandChallengeRules(v []interface{}) *vault.ChallengeRules {
 	challengerules := &vault.ChallengeRules{}
 	// Deal with root level
@@ -354,8 +449,6 @@ func expandCommandParams(v interface{}) []vault.DesktopAppParam {
 		parm.TargetObjectID = p.(map[string]interface{})["target_object_id"].(string)
 		parms = append(parms, parm)
 	}
-	logger.Debugf("Command params: %+v", parms)
-
 	return parms
 }
 
@@ -711,7 +804,6 @@ func convertWorkflowSchema(v string) (interface{}, error) {
 	var wfapprovers vault.ProxyWorkflowApprover
 	err := json.Unmarshal([]byte(str), &wfapprovers)
 	if err != nil {
-		logger.ErrorTracef(err.Error())
 		return nil, fmt.Errorf("failed to unmarshal ProxyWorkflowApprover: %v", err)
 	}
 	approvers, err := vault.GenerateSchemaMap(wfapprovers)
@@ -740,7 +832,6 @@ func convertZoneRoleSchema(v string) (interface{}, error) {
 	var zoneroles vault.ProxyZoneRole
 	err := json.Unmarshal([]byte(str), &zoneroles)
 	if err != nil {
-		logger.ErrorTracef(err.Error())
 		return nil, fmt.Errorf("failed to unmarshal ProxyZoneRole: %v", err)
 	}
 	zroles, err := vault.GenerateSchemaMap(zoneroles)
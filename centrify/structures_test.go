@@ -0,0 +1,135 @@
+package centrify
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	vault "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// fakeACLObject returns fixed permissions and sets instead of calling tenant
+type fakeACLObject struct {
+	permissions []vault.Permission
+	sets        []string
+}
+
+func (o *fakeACLObject) GetPermissions() ([]vault.Permission, error) { return o.permissions, nil }
+func (o *fakeACLObject) GetSets() ([]string, error)                  { return o.sets, nil }
+func (o *fakeACLObject) Rights() *vault.RightsMap {
+	return vault.LookupRights(vault.ValidPermissionMap.Account)
+}
+
+func TestReadPermissionsAndSets(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAccount().Schema, map[string]interface{}{
+		"name": "root",
+		"permission": []interface{}{
+			map[string]interface{}{"principal_id": "u1", "principal_name": "admin", "principal_type": "User", "rights": []interface{}{"grant"}},
+			map[string]interface{}{"principal_id": "r1", "principal_name": "Removed", "principal_type": "Role", "rights": []interface{}{"View"}},
+		},
+		"sets": []interface{}{"set-1", "set-2"},
+		"challenge_rule": []interface{}{
+			map[string]interface{}{"authentication_profile_id": "profile-1"},
+		},
+	})
+	object := &fakeACLObject{
+		permissions: []vault.Permission{
+			{PrincipalID: "u1", PrincipalName: "admin@example.com", PrincipalType: "user", RightList: []string{"Owner", "Naked"}},
+			{PrincipalID: "g1", PrincipalName: "Operators", PrincipalType: "Group", RightList: []string{"View", "Unknown"}},
+		},
+		sets: []string{"set-2", "set-3"},
+	}
+
+	if err := readPermissions(d, object); err != nil {
+		t.Fatal(err)
+	}
+	if err := readSets(d, object); err != nil {
+		t.Fatal(err)
+	}
+	clearAbsentAttributes(d, map[string]interface{}{"name": "root"}, "challenge_rule", "name")
+
+	permissions := make(map[string]map[string]interface{})
+	for _, v := range d.Get("permission").(*schema.Set).List() {
+		p := v.(map[string]interface{})
+		rights := flattenSchemaSetToStringSlice(p["rights"])
+		sort.Strings(rights)
+		permissions[p["principal_id"].(string)] = map[string]interface{}{
+			"principal_name": p["principal_name"],
+			"principal_type": p["principal_type"],
+			"rights":         rights,
+		}
+	}
+	expected := map[string]map[string]interface{}{
+		"u1": {"principal_name": "admin", "principal_type": "User", "rights": []string{"Checkout", "grant"}},
+		"g1": {"principal_name": "Operators", "principal_type": "Group", "rights": []string{"Unknown", "View"}},
+	}
+	if !reflect.DeepEqual(permissions, expected) {
+		t.Errorf("expected permissions %v, got %v", expected, permissions)
+	}
+
+	sets := flattenSchemaSetToStringSlice(d.Get("sets"))
+	sort.Strings(sets)
+	if !reflect.DeepEqual(sets, []string{"set-2"}) {
+		t.Errorf("expected sets [set-2], got %v", sets)
+	}
+	if rules := d.Get("challenge_rule").([]interface{}); len(rules) != 0 {
+		t.Errorf("expected challenge_rule to be cleared, got %v", rules)
+	}
+	if d.Get("name") != "root" {
+		t.Errorf("expected name to be kept, got %v", d.Get("name"))
+	}
+}
+
+func TestExpandPermissions(t *testing.T) {
+	permissionSchema := map[string]*schema.Schema{"permission": getPermissionSchema()}
+	d := schema.TestResourceDataRaw(t, permissionSchema, map[string]interface{}{
+		"permission": []interface{}{
+			map[string]interface{}{"principal_id": "u1", "principal_name": "admin", "principal_type": "User", "rights": []interface{}{"View", "checkout", "Grant"}},
+		},
+	})
+	permissions, err := expandPermissions(d.Get("permission"), vault.ValidPermissionMap.Account, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(permissions) != 1 || permissions[0].Rights != "Naked,Owner,View" {
+		t.Errorf("expected rights Naked,Owner,View, got %+v", permissions)
+	}
+
+	d = schema.TestResourceDataRaw(t, permissionSchema, map[string]interface{}{
+		"permission": []interface{}{
+			map[string]interface{}{"principal_id": "u1", "principal_name": "admin", "principal_type": "User", "rights": []interface{}{"View", "Run"}},
+		},
+	})
+	_, err = expandPermissions(d.Get("permission"), vault.ValidPermissionMap.Secret, true)
+	expected := "for admin, Invalid right Run for Secret, valid rights are Delete,Edit,Grant,RetrieveSecret,View"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got %v", expected, err)
+	}
+	if _, err := expandPermissions(d.Get("permission"), vault.ValidPermissionMap.Secret, false); err != nil {
+		t.Errorf("expected no error without validation, got %v", err)
+	}
+}
+
+func TestReadPermissionsUnmanaged(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceAccount().Schema, map[string]interface{}{"name": "root"})
+	object := &fakeACLObject{
+		permissions: []vault.Permission{
+			{PrincipalID: "u1", PrincipalName: "admin@example.com", PrincipalType: "User", RightList: []string{"Owner"}},
+		},
+		sets: []string{"set-1"},
+	}
+
+	if err := readPermissions(d, object); err != nil {
+		t.Fatal(err)
+	}
+	if err := readSets(d, object); err != nil {
+		t.Fatal(err)
+	}
+	if permissions := d.Get("permission").(*schema.Set); permissions.Len() != 0 {
+		t.Errorf("expected permissions granted outside of Terraform to be left out, got %v", permissions.List())
+	}
+	if sets := d.Get("sets").(*schema.Set); sets.Len() != 0 {
+		t.Errorf("expected sets to be left out, got %v", sets.List())
+	}
+}
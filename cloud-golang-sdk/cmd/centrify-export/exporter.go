@@ -0,0 +1,247 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+// exportedObject is an object read from tenant and converted to resource attributes
+type exportedObject struct {
+	kind  *exportKind
+	id    string
+	label string
+	attrs map[string]interface{}
+}
+
+// exporter reads objects from tenant and writes them as Terraform configuration
+type exporter struct {
+	client   *restapi.RestClient
+	objects  []*exportedObject
+	byID     map[string]*exportedObject
+	labels   map[string]bool // Resource addresses in use
+	warnings []string        // Objects that couldn't be exported
+}
+
+func newExporter(client *restapi.RestClient) *exporter {
+	return &exporter{
+		client: client,
+		byID:   make(map[string]*exportedObject),
+		labels: make(map[string]bool),
+	}
+}
+
+// export enumerates and reads all objects of kinds. Objects that fail to read are reported as warnings
+func (e *exporter) export(kinds []*exportKind) error {
+	for _, kind := range kinds {
+		ids, err := kind.list(e.client)
+		if err != nil {
+			return fmt.Errorf("error listing %s objects: %v", kind.name, err)
+		}
+		for _, id := range ids {
+			if err := e.exportObject(kind, id); err != nil {
+				e.warnings = append(e.warnings, fmt.Sprintf("%s %s: %v", kind.name, id, err))
+			}
+		}
+	}
+	e.resolveReferences()
+	return nil
+}
+
+func (e *exporter) exportObject(kind *exportKind, id string) error {
+	object, err := kind.read(e.client, id)
+	if err != nil {
+		return err
+	}
+	attrs, err := platform.GenerateSchemaMap(object)
+	if err != nil {
+		return err
+	}
+	if kind.convert != nil {
+		if err := kind.convert(object, attrs); err != nil {
+			return err
+		}
+	}
+	if kind.acl {
+		if err := exportPermissions(object, attrs); err != nil {
+			return err
+		}
+	}
+	for _, k := range kind.skip {
+		delete(attrs, k)
+	}
+	normalize(attrs)
+
+	var name string
+	if kind.label != nil {
+		name = kind.label(e, attrs)
+	} else {
+		name, _ = attrs["name"].(string)
+	}
+	o := &exportedObject{
+		kind:  kind,
+		id:    id,
+		label: e.uniqueLabel(kind, hclLabel(name, kind.name)),
+		attrs: attrs,
+	}
+	e.objects = append(e.objects, o)
+	e.byID[id] = o
+	return nil
+}
+
+// aclObject is implemented by objects whose permissions are exported
+type aclObject interface {
+	GetPermissions() ([]platform.Permission, error)
+	Rights() *platform.RightsMap
+}
+
+// exportPermissions adds permission blocks with rights converted to names used in configuration. Rights that
+// aren't valid for object fail the export so that configuration is never written with them
+func exportPermissions(object interface{}, attrs map[string]interface{}) error {
+	o, ok := object.(aclObject)
+	if !ok {
+		return nil
+	}
+	permissions, err := o.GetPermissions()
+	if err != nil {
+		return fmt.Errorf("failed to read permissions: %v", err)
+	}
+	var blocks []interface{}
+	for _, p := range permissions {
+		rights, err := o.Rights().FromAPI(p.RightList)
+		if err != nil {
+			return fmt.Errorf("permission of %s: %v", p.PrincipalName, err)
+		}
+		var list []interface{}
+		for _, r := range rights {
+			list = append(list, r)
+		}
+		blocks = append(blocks, map[string]interface{}{
+			"principal_id":   p.PrincipalID,
+			"principal_name": p.PrincipalName,
+			"principal_type": p.PrincipalType,
+			"rights":         list,
+		})
+	}
+	attrs["permission"] = blocks
+	return nil
+}
+
+// uniqueLabel appends number to label if the resource address is already used
+func (e *exporter) uniqueLabel(kind *exportKind, label string) string {
+	unique := label
+	for i := 2; e.labels[kind.resource+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	e.labels[kind.resource+"."+unique] = true
+	return unique
+}
+
+// isReferenceAttribute reports whether attribute holds ID(s) of other objects
+func isReferenceAttribute(k string) bool {
+	return k == "id" || strings.HasSuffix(k, "_id") || k == "sets" || k == "policy_assignment"
+}
+
+// resolveReferences replaces IDs of exported objects by references to their resources
+func (e *exporter) resolveReferences() {
+	for _, o := range e.objects {
+		e.replaceReferences(o, o.attrs)
+	}
+}
+
+func (e *exporter) replaceReferences(self *exportedObject, attrs map[string]interface{}) {
+	for k, v := range attrs {
+		switch value := v.(type) {
+		case string:
+			if isReferenceAttribute(k) {
+				attrs[k] = e.reference(self, value)
+			}
+		case map[string]interface{}:
+			e.replaceReferences(self, value)
+		case []interface{}:
+			for i, item := range value {
+				switch itemValue := item.(type) {
+				case string:
+					if isReferenceAttribute(k) {
+						value[i] = e.reference(self, itemValue)
+					}
+				case map[string]interface{}:
+					e.replaceReferences(self, itemValue)
+				}
+			}
+		}
+	}
+}
+
+// reference returns expression referencing exported object with the ID or ID itself if it isn't exported
+func (e *exporter) reference(self *exportedObject, id string) interface{} {
+	o, ok := e.byID[id]
+	if !ok || o == self {
+		return id
+	}
+	return hclExpression(o.kind.resource + "." + o.label + ".id")
+}
+
+// write writes one <resource type>.tf file per kind into dir
+func (e *exporter) write(dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	byKind := make(map[*exportKind][]*exportedObject)
+	var kinds []*exportKind
+	for _, o := range e.objects {
+		if _, ok := byKind[o.kind]; !ok {
+			kinds = append(kinds, o.kind)
+		}
+		byKind[o.kind] = append(byKind[o.kind], o)
+	}
+
+	var files []string
+	for _, kind := range kinds {
+		objects := byKind[kind]
+		sort.Slice(objects, func(i, j int) bool { return objects[i].label < objects[j].label })
+
+		var w bytes.Buffer
+		for i, o := range objects {
+			if i > 0 {
+				w.WriteString("\n")
+			}
+			writeResource(&w, kind.resource, o.label, o.id, o.attrs)
+		}
+		file := filepath.Join(dir, kind.resource+".tf")
+		if err := ioutil.WriteFile(file, w.Bytes(), 0644); err != nil {
+			return files, err
+		}
+		files = append(files, file)
+	}
+	return files, nil
+}
+
+// selectKinds returns kinds named in comma separated list in export order. Empty list selects all kinds
+func selectKinds(names string) ([]*exportKind, error) {
+	if names == "" {
+		return exportKinds, nil
+	}
+	selected := make(map[string]bool)
+	for _, name := range strings.Split(names, ",") {
+		selected[strings.TrimSpace(name)] = true
+	}
+	var kinds []*exportKind
+	for _, kind := range exportKinds {
+		if selected[kind.name] {
+			kinds = append(kinds, kind)
+			delete(selected, kind.name)
+		}
+	}
+	for name := range selected {
+		return nil, fmt.Errorf("unsupported type %s", name)
+	}
+	return kinds, nil
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+)
+
+func TestHCLString(t *testing.T) {
+	cases := map[string]string{
+		`plain`:                `"plain"`,
+		`say "hi" \ bye`:       `"say \"hi\" \\ bye"`,
+		"multi\nline\ttab":     `"multi\nline\ttab"`,
+		"${var.x} %{if} $ % {": `"$${var.x} %%{if} $ % {"`,
+		"bell\a":               `"bell\u0007"`,
+	}
+	for in, expected := range cases {
+		if out := hclString(in); out != expected {
+			t.Errorf("%q: expected %s, got %s", in, expected, out)
+		}
+	}
+}
+
+func TestHCLLabel(t *testing.T) {
+	cases := map[string]string{
+		"Web Server 01":  "web_server_01",
+		"10.0.0.1":       "system_10_0_0_1",
+		"admin@corp.com": "admin_corp_com",
+		"---":            "system",
+		"":               "system",
+	}
+	for in, expected := range cases {
+		if out := hclLabel(in, "system"); out != expected {
+			t.Errorf("%q: expected %s, got %s", in, expected, out)
+		}
+	}
+}
+
+// kindWithObjects returns copy of named kind that lists and reads given objects instead of calling tenant
+func kindWithObjects(t *testing.T, name string, objects map[string]interface{}) *exportKind {
+	kinds, err := selectKinds(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	kind := *kinds[0]
+	kind.list = func(client *restapi.RestClient) ([]string, error) {
+		var ids []string
+		for id := range objects {
+			ids = append(ids, id)
+		}
+		return ids, nil
+	}
+	kind.read = func(client *restapi.RestClient, id string) (interface{}, error) {
+		return objects[id], nil
+	}
+	return &kind
+}
+
+// aclServer serves access control entries of object whose ID is in RowKey
+func aclServer(t *testing.T, aces map[string][]map[string]interface{}) *httptest.Server {
+	handler := func(r *restapitest.Request) interface{} {
+		key, _ := r.Args["RowKey"].(string)
+		if result := aces[key]; result != nil {
+			return result
+		}
+		return []map[string]interface{}{}
+	}
+	return restapitest.NewServer(t, map[string]restapitest.Handler{
+		"/Acl/GetRowAces":        handler,
+		"/Acl/GetCollectionAces": handler,
+	})
+}
+
+func TestExport(t *testing.T) {
+	server := aclServer(t, map[string][]map[string]interface{}{
+		"system-1":  {{"PrincipalId": "role-1", "PrincipalName": "Admins", "PrincipalType": "Role", "GrantStr": "View,Grant"}},
+		"account-1": {{"PrincipalId": "user-1", "PrincipalName": "admin@example.com", "PrincipalType": "User", "GrantStr": "Naked,Owner"}},
+	})
+	defer server.Close()
+	client, err := restapi.GetNewRestClient(server.URL, server.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set := platform.NewManualSet(client)
+	set.ID = "set-1"
+	set.Name = "Linux Servers"
+	set.ObjectType = "Server"
+	set.CollectionType = "ManualBucket"
+
+	system := platform.NewSystem(client)
+	system.ID = "system-1"
+	system.Name = "web01"
+	system.FQDN = "web01.example.com"
+	system.ComputerClass = "Unix"
+	system.SessionType = "Ssh"
+	system.Status = "Reachable"
+	system.Port = 22
+	system.ProxyCollectionList = "connector-1,connector-2"
+	system.Sets = []string{"set-1", "set-unknown"}
+	system.ChallengeRules = &platform.ChallengeRules{
+		Enabled: true,
+		Rules:   []platform.ChallengeRule{{AuthProfileID: "profile-1"}},
+	}
+
+	account := platform.NewAccount(client)
+	account.ID = "account-1"
+	account.User = "root"
+	account.Host = "system-1"
+	account.CredentialType = "Password"
+	account.Password = "secret"
+	account.ResolveValidPermissions()
+
+	e := newExporter(client)
+	err = e.export([]*exportKind{
+		kindWithObjects(t, "manualset", map[string]interface{}{"set-1": set}),
+		kindWithObjects(t, "system", map[string]interface{}{"system-1": system}),
+		kindWithObjects(t, "account", map[string]interface{}{"account-1": account}),
+	})
+	if err != nil || len(e.warnings) > 0 {
+		t.Fatalf("export failed: %v %v", err, e.warnings)
+	}
+
+	dir, err := ioutil.TempDir("", "centrify-export")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files, err := e.write(dir)
+	if err != nil || len(files) != 3 {
+		t.Fatalf("expected 3 files, got %v %v", files, err)
+	}
+
+	expected := map[string]string{
+		"centrify_system.tf": `resource "centrify_system" "web01" {
+  computer_class                               = "Unix"
+  connector_list                               = ["connector-1", "connector-2"]
+  fqdn                                         = "web01.example.com"
+  name                                         = "web01"
+  port                                         = 22
+  proxyuser_managed                            = false
+  session_type                                 = "Ssh"
+  sets                                         = [centrify_manualset.linux_servers.id, "set-unknown"]
+  use_domain_assignment_for_zonerole_approvers = false
+  use_domain_assignment_for_zoneroles          = false
+
+  challenge_rule {
+    authentication_profile_id = "profile-1"
+  }
+
+  permission {
+    principal_id   = "role-1"
+    principal_name = "Admins"
+    principal_type = "Role"
+    rights         = ["Grant", "View"]
+  }
+}
+
+import {
+  to = centrify_system.web01
+  id = "system-1"
+}
+`,
+		"centrify_account.tf": `resource "centrify_account" "web01_root" {
+  credential_type = "Password"
+  host_id         = centrify_system.web01.id
+  name            = "root"
+
+  permission {
+    principal_id   = "user-1"
+    principal_name = "admin@example.com"
+    principal_type = "User"
+    rights         = ["Checkout", "Grant"]
+  }
+}
+
+import {
+  to = centrify_account.web01_root
+  id = "account-1"
+}
+`,
+	}
+	for file, content := range expected {
+		b, err := ioutil.ReadFile(filepath.Join(dir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != content {
+			t.Errorf("%s: expected\n%s\ngot\n%s", file, content, b)
+		}
+	}
+}
+
+func TestExportRejectsInvalidRights(t *testing.T) {
+	server := aclServer(t, map[string][]map[string]interface{}{
+		"key-1": {{"PrincipalId": "user-1", "PrincipalName": "admin@example.com", "PrincipalType": "User", "GrantStr": "Owner,Execute"}},
+	})
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	key := platform.NewSSHKey(client)
+	key.ID = "key-1"
+	key.Name = "deploy"
+
+	e := newExporter(client)
+	if err := e.export([]*exportKind{kindWithObjects(t, "sshkey", map[string]interface{}{"key-1": key})}); err != nil {
+		t.Fatal(err)
+	}
+	expected := "sshkey key-1: permission of admin@example.com: Invalid right Execute for SSHKey, valid rights are Delete,Edit,Grant,Retrieve,View"
+	if len(e.objects) != 0 || len(e.warnings) != 1 || e.warnings[0] != expected {
+		t.Errorf("expected warning %q, got %v %v", expected, e.objects, e.warnings)
+	}
+}
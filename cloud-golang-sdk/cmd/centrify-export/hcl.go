@@ -0,0 +1,165 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// hclExpression is written to configuration as is instead of as quoted string, e.g. centrify_system.web01.id
+type hclExpression string
+
+var invalidLabelChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// hclLabel converts name into valid Terraform identifier
+func hclLabel(name string, fallback string) string {
+	label := strings.Trim(invalidLabelChars.ReplaceAllString(strings.ToLower(name), "_"), "_-")
+	if label == "" {
+		return fallback
+	}
+	if c := label[0]; c >= '0' && c <= '9' {
+		label = fallback + "_" + label
+	}
+	return label
+}
+
+// hclString quotes s as HCL string literal. Template sequences are escaped so that value is taken literally
+func hclString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteRune(r)
+		case r == '\n':
+			b.WriteString(`\n`)
+		case r == '\r':
+			b.WriteString(`\r`)
+		case r == '\t':
+			b.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&b, `\u%04x`, r)
+		case (r == '$' || r == '%') && strings.HasPrefix(s[i+1:], "{"):
+			b.WriteRune(r)
+			b.WriteRune(r)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// hclValue formats scalar or list of scalars
+func hclValue(v interface{}) string {
+	switch value := v.(type) {
+	case hclExpression:
+		return string(value)
+	case string:
+		return hclString(value)
+	case bool:
+		return strconv.FormatBool(value)
+	case int:
+		return strconv.Itoa(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case []interface{}:
+		items := make([]string, len(value))
+		for i, item := range value {
+			items[i] = hclValue(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	default:
+		return hclString(fmt.Sprintf("%v", value))
+	}
+}
+
+// isBlock reports whether attribute value is written as nested block(s) instead of argument
+func isBlock(v interface{}) bool {
+	switch value := v.(type) {
+	case map[string]interface{}:
+		return true
+	case []interface{}:
+		if len(value) == 0 {
+			return false
+		}
+		_, ok := value[0].(map[string]interface{})
+		return ok
+	}
+	return false
+}
+
+// isEmpty reports whether attribute has no value and can be omitted
+func isEmpty(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case []interface{}:
+		return len(value) == 0
+	case map[string]interface{}:
+		for _, item := range value {
+			if !isEmpty(item) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// writeBody writes attributes as block body. Arguments come first with aligned equal signs followed by
+// nested blocks, each group sorted by name
+func writeBody(w *bytes.Buffer, indent int, attrs map[string]interface{}) {
+	var args, blocks []string
+	width := 0
+	for k, v := range attrs {
+		if isEmpty(v) {
+			continue
+		}
+		if isBlock(v) {
+			blocks = append(blocks, k)
+			continue
+		}
+		args = append(args, k)
+		if len(k) > width {
+			width = len(k)
+		}
+	}
+	sort.Strings(args)
+	sort.Strings(blocks)
+
+	pad := strings.Repeat("  ", indent)
+	for _, k := range args {
+		fmt.Fprintf(w, "%s%-*s = %s\n", pad, width, k, hclValue(attrs[k]))
+	}
+	for _, k := range blocks {
+		var items []interface{}
+		switch value := attrs[k].(type) {
+		case map[string]interface{}:
+			items = []interface{}{value}
+		case []interface{}:
+			items = value
+		}
+		for _, item := range items {
+			if w.Len() > 0 && !bytes.HasSuffix(w.Bytes(), []byte("{\n")) {
+				w.WriteString("\n")
+			}
+			fmt.Fprintf(w, "%s%s {\n", pad, k)
+			writeBody(w, indent+1, item.(map[string]interface{}))
+			fmt.Fprintf(w, "%s}\n", pad)
+		}
+	}
+}
+
+// writeResource writes resource block followed by import block that imports it by ID
+func writeResource(w *bytes.Buffer, resource string, label string, id string, attrs map[string]interface{}) {
+	fmt.Fprintf(w, "resource %s %s {\n", hclString(resource), hclString(label))
+	writeBody(w, 1, attrs)
+	fmt.Fprintf(w, "}\n\nimport {\n  to = %s.%s\n  id = %s\n}\n", resource, label, hclString(id))
+}
@@ -0,0 +1,306 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+// exportKind describes how objects of a Terraform resource type are enumerated, read and converted
+type exportKind struct {
+	name     string // Value used in -types, e.g. system
+	resource string // Terraform resource type, e.g. centrify_system
+	list     func(client *restapi.RestClient) ([]string, error)
+	read     func(client *restapi.RestClient, id string) (interface{}, error)
+	skip     []string                                                     // Attributes that are output only, sensitive or not in resource schema
+	convert  func(object interface{}, attrs map[string]interface{}) error // Optional conversion to resource schema
+	label    func(e *exporter, attrs map[string]interface{}) string       // Optional resource name. Defaults to name attribute
+	acl      bool                                                         // Whether permission blocks are exported
+}
+
+// challengeRuleAttributes hold ChallengeRules struct whose rules are written as blocks
+var challengeRuleAttributes = map[string]bool{
+	"challenge_rule":              true,
+	"privilege_elevation_rule":    true,
+	"access_secret_checkout_rule": true,
+}
+
+// exportKinds lists supported kinds in export order. Objects that are referenced come before objects referencing them
+var exportKinds = []*exportKind{
+	{
+		name:     "role",
+		resource: "centrify_role",
+		list:     listTable("Role"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewRole(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id", "sets"},
+	},
+	{
+		name:     "manualset",
+		resource: "centrify_manualset",
+		acl:      true,
+		list:     listTable("Sets", "CollectionType", "ManualBucket"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewManualSet(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id", "sets", "collection_type"},
+	},
+	{
+		name:     "secretfolder",
+		resource: "centrify_secretfolder",
+		acl:      true,
+		list:     listTable("Sets", "ObjectType", "DataVault", "CollectionType", "Phantom"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewSecretFolder(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id", "sets", "type"},
+	},
+	{
+		name:     "domain",
+		resource: "centrify_domain",
+		acl:      true,
+		list:     listTable("VaultDomain"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewDomain(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		// Domain configuration is exported by centrify_domainconfiguration which isn't supported yet
+		skip: []string{
+			"id", "administrative_account_id", "administrative_account_name", "administrative_account_password",
+			"administrator_display_name", "assigned_zonerole", "assigned_zonerole_approver", "assigned_zonerole_approvers",
+			"assigned_zoneroles", "auto_domain_account_maintenance", "auto_local_account_maintenance",
+			"manual_domain_account_unlock", "manual_local_account_unlock", "provisioning_admin_id",
+			"reconciliation_account_name", "zonerole_workflow_enabled",
+		},
+	},
+	{
+		name:     "system",
+		resource: "centrify_system",
+		acl:      true,
+		list:     listTable("Server"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewSystem(client)
+			object.ID = id
+			if err := object.Read(); err != nil {
+				return nil, err
+			}
+			object.ResolveValidPermissions()
+			return object, nil
+		},
+		skip: []string{"id", "status", "proxyuser_password"},
+		convert: func(object interface{}, attrs map[string]interface{}) error {
+			if err := expandJSONAttribute(attrs, "assigned_zoneroles", "assigned_zonerole", &platform.ProxyZoneRole{}, "ZoneRoleWorkflowRole", "proxy_zonerole"); err != nil {
+				return err
+			}
+			return expandJSONAttribute(attrs, "assigned_zonerole_approvers", "assigned_zonerole_approver", &platform.ProxyWorkflowApprover{}, "WorkflowApprover", "proxy_approver")
+		},
+	},
+	{
+		name:     "database",
+		resource: "centrify_database",
+		acl:      true,
+		list:     listTable("VaultDatabase"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewDatabase(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id"},
+	},
+	{
+		name:     "cloudprovider",
+		resource: "centrify_cloudprovider",
+		acl:      true,
+		list:     listTable("CloudProviders"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewCloudProvider(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id"},
+	},
+	{
+		name:     "sshkey",
+		resource: "centrify_sshkey",
+		acl:      true,
+		list:     listTable("SshKeys"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewSSHKey(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id", "is_managed", "key_format", "key_length", "key_pair_type", "private_key", "passphrase"},
+	},
+	{
+		name:     "account",
+		resource: "centrify_account",
+		acl:      true,
+		list:     listTable("VaultAccount"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewAccount(client)
+			object.ID = id
+			if err := object.Read(); err != nil {
+				return nil, err
+			}
+			object.ResolveValidPermissions()
+			return object, nil
+		},
+		skip: []string{"id", "status", "password", "credential_id", "credential_name", "workflow_default_options"},
+		convert: func(object interface{}, attrs map[string]interface{}) error {
+			if !object.(*platform.Account).WorkflowEnabled {
+				delete(attrs, "workflow_approvers")
+				return nil
+			}
+			return expandJSONAttribute(attrs, "workflow_approvers", "workflow_approver", &platform.ProxyWorkflowApprover{}, "WorkflowApprover", "proxy_approver")
+		},
+		label: func(e *exporter, attrs map[string]interface{}) string {
+			// Prefix account name with name of the system, database, domain or cloud provider
+			name, _ := attrs["name"].(string)
+			for _, k := range []string{"host_id", "database_id", "domain_id", "cloudprovider_id"} {
+				if id, ok := attrs[k].(string); ok && e.byID[id] != nil {
+					return e.byID[id].label + "_" + name
+				}
+			}
+			return name
+		},
+	},
+	{
+		name:     "secret",
+		resource: "centrify_secret",
+		acl:      true,
+		list:     listTable("DataVault"),
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewSecret(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id", "name", "secret_text", "workflow_default_options"},
+		label: func(e *exporter, attrs map[string]interface{}) string {
+			name, _ := attrs["secret_name"].(string)
+			return name
+		},
+	},
+	{
+		name:     "policy",
+		resource: "centrify_policy",
+		list: func(client *restapi.RestClient) ([]string, error) {
+			links := platform.NewPolicyLinks(client)
+			if err := links.Read(); err != nil {
+				return nil, err
+			}
+			var ids []string
+			for _, link := range links.Plinks {
+				ids = append(ids, link.ID)
+			}
+			return ids, nil
+		},
+		read: func(client *restapi.RestClient, id string) (interface{}, error) {
+			object := platform.NewPolicy(client)
+			object.ID = id
+			return object, object.Read()
+		},
+		skip: []string{"id", "sets", "position"},
+		convert: func(object interface{}, attrs map[string]interface{}) error {
+			// Policy name is part of path while link type and assignment are in plink, the way resource read handles them
+			policy := object.(*platform.Policy)
+			attrs["name"] = strings.TrimPrefix(policy.Path, "/Policy/")
+			delete(attrs, "path")
+			delete(attrs, "plink")
+			if policy.Plink != nil {
+				attrs["link_type"] = policy.Plink.LinkType
+				var assignment []interface{}
+				for _, p := range policy.Plink.Params {
+					assignment = append(assignment, p)
+				}
+				attrs["policy_assignment"] = assignment
+			}
+			return nil
+		},
+	},
+}
+
+// listTable returns function that lists IDs of RedRock table rows matching column value pairs
+func listTable(table string, conditions ...string) func(client *restapi.RestClient) ([]string, error) {
+	return func(client *restapi.RestClient) ([]string, error) {
+		query := platform.NewQueryBuilder(table).Select("ID")
+		for i := 0; i+1 < len(conditions); i += 2 {
+			query.Equal(conditions[i], conditions[i+1])
+		}
+		query.OrderBy("ID", true)
+
+		var ids []string
+		err := query.Iterate(client, 0).ForEach(func(row map[string]interface{}) error {
+			if id, ok := row["ID"].(string); ok {
+				ids = append(ids, id)
+			}
+			return nil
+		})
+		return ids, err
+	}
+}
+
+// expandJSONAttribute replaces attribute holding JSON encoded list by nested blocks of attribute to. JSON is
+// decoded into proxy under wrapper key and converted by GenerateSchemaMap whose key value becomes the blocks
+func expandJSONAttribute(attrs map[string]interface{}, from string, to string, proxy interface{}, wrapper string, key string) error {
+	v, _ := attrs[from].(string)
+	delete(attrs, from)
+	if v == "" {
+		return nil
+	}
+	str := strings.Replace("{\""+wrapper+"\":"+v+"}", "\\", "", -1)
+	if err := json.Unmarshal([]byte(str), proxy); err != nil {
+		return fmt.Errorf("failed to decode %s: %v", from, err)
+	}
+	expanded, err := platform.GenerateSchemaMap(proxy)
+	if err != nil {
+		return err
+	}
+	attrs[to] = expanded[key]
+	return nil
+}
+
+// normalize converts generic schema map values to the shape of resource schema. Challenge rules are written as
+// their rule list and comma separated connector list as list
+func normalize(attrs map[string]interface{}) {
+	for k, v := range attrs {
+		switch value := v.(type) {
+		case map[string]interface{}:
+			if challengeRuleAttributes[k] {
+				attrs[k] = value["rule"]
+				normalizeList(value["rule"])
+				continue
+			}
+			normalize(value)
+		case []interface{}:
+			normalizeList(value)
+		case string:
+			if k == "connector_list" && value != "" {
+				var connectors []interface{}
+				for _, c := range strings.Split(value, ",") {
+					connectors = append(connectors, c)
+				}
+				attrs[k] = connectors
+			}
+		}
+	}
+}
+
+func normalizeList(v interface{}) {
+	list, _ := v.([]interface{})
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			normalize(m)
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/utils"
+)
+
+func main() {
+	var names []string
+	for _, kind := range exportKinds {
+		names = append(names, kind.name)
+	}
+	outPtr := flag.String("out", ".", "Directory where .tf files are written")
+	typesPtr := flag.String("types", "", "Comma separated list of object types to export <"+strings.Join(names, "|")+">. All types are exported if this isn't provided")
+
+	vault := &utils.VaultClient{}
+	vault.GetCmdParms()
+
+	kinds, err := selectKinds(*typesPtr)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	// Authenticate and returns authenticated REST client
+	client, err := vault.GetClient()
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+
+	e := newExporter(client)
+	if err := e.export(kinds); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	files, err := e.write(*outPtr)
+	for _, file := range files {
+		fmt.Printf("Wrote %s\n", file)
+	}
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Exported %d objects\n", len(e.objects))
+
+	if len(e.warnings) > 0 {
+		fmt.Printf("Failed to export %d objects:\n", len(e.warnings))
+		for _, w := range e.warnings {
+			fmt.Printf("  %s\n", w)
+		}
+		os.Exit(1)
+	}
+}
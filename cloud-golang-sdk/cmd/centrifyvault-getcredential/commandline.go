@@ -8,6 +8,7 @@ import (
 	"syscall"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/authenticationtype"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/tokencache"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/utils"
 	"golang.org/x/crypto/ssh/terminal"
 )
@@ -15,26 +16,34 @@ import (
 // getCmdParms parse command line argument
 func getCmdParms(c *utils.VaultClient, p *CliParameters) {
 	// Common arguments
-	authTypePtr := flag.String("auth", "oauth", "Authentication type <oauth|unpw|dmc>")
+	authTypePtr := flag.String("auth", "oauth", "Authentication type <oauth|oauthpkce|oauthdevice|unpw|dmc>")
 	urlPtr := flag.String("url", "", "Centrify tenant URL (Required)")
 	skipCertPtr := flag.Bool("skipcert", false, "Ignore certification verification")
 	debugPtr := flag.Bool("debug", false, "Trun on debug logging")
+	cachePtr := flag.Bool("cache", false, "Cache token in encrypted file so that subsequent runs don't need to authenticate. Passphrase is read from "+tokencache.PassphraseEnv)
 
 	// Other arguments
-	appIDPtr := flag.String("appid", "", "OAuth2 application ID. Required if auth = oauth")
-	scopePtr := flag.String("scope", "", "OAuth2 or DMC scope definition. Required if auth = oauth or dmc")
+	appIDPtr := flag.String("appid", "", "OAuth2 application ID. Required if auth = oauth, oauthpkce or oauthdevice")
+	scopePtr := flag.String("scope", "", "OAuth2 or DMC scope definition. Required if auth = oauth, oauthpkce, oauthdevice or dmc")
 	tokenPtr := flag.String("token", "", "OAuth2 or DMC token. Optional if auth = oauth or dmc")
-	usernamePtr := flag.String("user", "", "Authorized user to login to tenant. Required if auth = unpw. Optional if auth = oauth")
+	usernamePtr := flag.String("user", "", "Authorized user to login to tenant. Required if auth = unpw. Optional if auth = oauth. OAuth2 client ID if auth = oauthpkce or oauthdevice, defaults to appid")
 	passwordPtr := flag.String("password", "", "User password. You will be prompted to enter password if this isn't provided")
+	portPtr := flag.Int("port", 0, "Loopback port receiving OAuth2 redirect if auth = oauthpkce. Any free port is used if this isn't provided")
 	credPathPtr := flag.String("credpath", "", "Path of the secret/pasword to be retrieved.")
 	saveToHomePtr := flag.Bool("savetohome", false, "Save downloaded secret file to user's home directory instead of current directory")
+	holdPtr := flag.Bool("hold", false, "Keep account password checked out, extending checkout before it expires, until interrupted. Otherwise password is checked in before exit")
+	logoutPtr := flag.Bool("logout", false, "Remove cached token of the tenant, auth type, user and scope, then exit")
+	purgePtr := flag.Bool("purge", false, "Remove all cached tokens, then exit")
 
 	prgname := os.Args[0]
 	flag.Usage = func() {
 		fmt.Printf("Usage: %s -auth dmc -url https://tenant.my.centrify.net -scope scope -credpath \"system/systemname/accountname\"\n", prgname)
 		fmt.Printf("Usage: %s -auth oauth -token <oauthtoken> -url https://tenant.my.centrify.net -credpath \"secret/folder1\\folder2/secretname\"\n", prgname)
 		fmt.Printf("Usage: %s -auth oauth -url https://tenant.my.centrify.net -appid <appid> -scope <scope> -user <username> -credpath \"secret/folder1/secretname\"\n", prgname)
+		fmt.Printf("Usage: %s -auth oauthpkce -url https://tenant.my.centrify.net -appid <appid> -scope <scope> -credpath \"secret/folder1/secretname\"\n", prgname)
 		fmt.Printf("Usage: %s -auth unpw -url https://tenant.my.centrify.net -user <username> -credpath \"cloudprovider/My AWS/iamaccount/accesskeyid\"\n", prgname)
+		fmt.Printf("Usage: %s -auth unpw -url https://tenant.my.centrify.net -user <username> -logout\n", prgname)
+		fmt.Printf("Usage: %s -purge\n", prgname)
 		flag.PrintDefaults()
 	}
 
@@ -46,8 +55,20 @@ func getCmdParms(c *utils.VaultClient, p *CliParameters) {
 		os.Exit(1)
 	}
 
+	if *purgePtr {
+		cache, err := tokencache.New("", "")
+		if err == nil {
+			err = cache.Purge()
+		}
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	// Verify authTypePtr value
-	authChoices := map[string]bool{"oauth": true, "unpw": true, "dmc": true}
+	authChoices := map[string]bool{"oauth": true, "oauthpkce": true, "oauthdevice": true, "unpw": true, "dmc": true}
 	if _, validChoice := authChoices[*authTypePtr]; !validChoice {
 		flag.Usage()
 		os.Exit(1)
@@ -58,12 +79,43 @@ func getCmdParms(c *utils.VaultClient, p *CliParameters) {
 		os.Exit(1)
 	}
 
+	if *logoutPtr {
+		c.AuthType = *authTypePtr
+		c.URL = *urlPtr
+		c.User = *usernamePtr
+		c.Scope = *scopePtr
+		cache, err := tokencache.New("", "")
+		if err == nil {
+			c.Cache = cache
+			err = c.Logout()
+		}
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+
 	if *credPathPtr == "" {
 		fmt.Print("Missing -credpath vaule")
 		flag.Usage()
 		os.Exit(1)
 	}
 
+	// Password isn't needed if there is a cached token
+	var cache *tokencache.Cache
+	cached := false
+	if *cachePtr {
+		var err error
+		cache, err = tokencache.New("", "")
+		if err != nil {
+			fmt.Printf("Token cache is disabled: %v\n", err)
+		} else {
+			entry, _ := cache.Load(tokencache.Key{URL: *urlPtr, AuthType: *authTypePtr, User: *usernamePtr, Scope: *scopePtr})
+			cached = entry != nil
+		}
+	}
+
 	switch strings.ToLower(*authTypePtr) {
 	case authenticationtype.OAuth2.String():
 		if (*appIDPtr == "" || *scopePtr == "") && *tokenPtr == "" {
@@ -76,20 +128,26 @@ func getCmdParms(c *utils.VaultClient, p *CliParameters) {
 			os.Exit(1)
 		}
 		// If password isn't provided, prompt for it
-		if *passwordPtr == "" && *tokenPtr == "" {
+		if *passwordPtr == "" && *tokenPtr == "" && !cached {
 			fmt.Print("Enter Password: ")
 			bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
 			password := strings.TrimSpace(string(bytePassword))
 			*passwordPtr = password
 			fmt.Println()
 		}
+	case authenticationtype.OAuth2PKCE.String(), authenticationtype.OAuth2DeviceCode.String():
+		// User logs in with browser so password isn't needed
+		if *appIDPtr == "" || *scopePtr == "" {
+			flag.Usage()
+			os.Exit(1)
+		}
 	case authenticationtype.UsernamePassword.String():
 		if *urlPtr == "" || *usernamePtr == "" {
 			flag.Usage()
 			os.Exit(1)
 		}
 		// If password isn't provided, prompt for it
-		if *passwordPtr == "" {
+		if *passwordPtr == "" && !cached {
 			fmt.Print("Enter Password: ")
 			bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
 			password := strings.TrimSpace(string(bytePassword))
@@ -112,7 +170,10 @@ func getCmdParms(c *utils.VaultClient, p *CliParameters) {
 	c.User = *usernamePtr
 	c.Password = *passwordPtr
 	c.Skipcert = *skipCertPtr
+	c.Port = *portPtr
 	c.Debug = *debugPtr
+	c.Cache = cache
 	p.CredentialPath = *credPathPtr
 	p.SaveToHome = *saveToHomePtr
+	p.Hold = *holdPtr
 }
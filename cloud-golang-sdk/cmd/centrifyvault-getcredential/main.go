@@ -1,9 +1,12 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/resourcetype"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/platform"
@@ -14,6 +17,7 @@ import (
 type CliParameters struct {
 	CredentialPath string
 	SaveToHome     bool
+	Hold           bool
 }
 
 type vaultObject struct {
@@ -53,13 +57,22 @@ func main() {
 		acct.User = vo.secretName
 		acct.ResourceName = vo.resourceName
 		acct.ResourceType = vo.resourceType
-		// Checkout password
-		pw, err := acct.CheckoutPassword(false)
+		// Checkout password. It is checked in before exit, or when interrupted if it is held
+		ctx, cancel := interruptContext()
+		defer cancel()
+		lease, err := acct.CheckoutPasswordLease(ctx, pars.Hold)
 		if err != nil {
 			fmt.Println("Error: ", err)
 			os.Exit(1)
 		}
-		fmt.Print(pw)
+		fmt.Print(lease.Password)
+		if pars.Hold {
+			<-ctx.Done()
+		}
+		if err := lease.Close(); err != nil {
+			fmt.Println("Error: ", err)
+			os.Exit(1)
+		}
 	case resourcetype.CloudProvider.String():
 		acct := platform.NewAccount(client)
 		acct.User = vo.secretName
@@ -85,6 +98,22 @@ func main() {
 	}
 }
 
+// interruptContext returns a context that is cancelled on SIGINT or SIGTERM
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+		signal.Stop(sigs)
+	}()
+	return ctx, cancel
+}
+
 func getVaultObject(credPath string) (*vaultObject, error) {
 	var vo vaultObject
 	credparts := strings.Split(credPath, "/")
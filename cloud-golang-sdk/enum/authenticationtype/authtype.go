@@ -7,13 +7,17 @@ const (
 	OAuth2 AuthenticationType = iota
 	UsernamePassword
 	DelegatedMachineCredential
+	OAuth2PKCE
+	OAuth2DeviceCode
 )
 
 func (r AuthenticationType) String() string {
 	names := [...]string{
 		"oauth",
 		"unpw",
-		"dmc"}
+		"dmc",
+		"oauthpkce",
+		"oauthdevice"}
 
 	return names[r]
 }
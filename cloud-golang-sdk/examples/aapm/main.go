@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -27,17 +28,15 @@ func main() {
 	acct1.ResourceName = "MySQL (Demo Lab)"
 	acct1.ResourceType = resourcetype.System.String()
 
-	// Checkout password
-	pw, err := acct1.CheckoutPassword(false)
-	if pw == "" && err != nil {
+	// Checkout password. It stays checked out until the lease is closed
+	lease, err := acct1.CheckoutPasswordLease(context.Background(), false)
+	if err != nil {
 		fmt.Println(err)
 		os.Exit(1)
 	}
-	if pw != "" {
-		fmt.Printf("Password for account %s in %s is: %s\n", acct1.User, acct1.ResourceName, pw)
-		if err != nil {
-			fmt.Printf("Password checkin error: %+v\n", err)
-		}
+	fmt.Printf("Password for account %s in %s is: %s, checkout expires at %s\n", acct1.User, acct1.ResourceName, lease.Password, lease.Expiry())
+	if err := lease.Close(); err != nil {
+		fmt.Printf("Password checkin error: %+v\n", err)
 	}
 
 	///////////////////////////////////////////////
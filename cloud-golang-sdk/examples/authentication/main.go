@@ -49,12 +49,12 @@ func main() {
 	}
 
 	// Sample usage of using authenticated REST client to query users
-	sql := "Select ID, Username from User"
-	var args map[string]interface{}
+	query := platform.NewQueryBuilder("User").Select("ID", "Username").OrderBy("Username", true)
+	args := make(map[string]interface{})
 	args["Caching"] = -1
 	//args["PageSize"] = 10000
 	//args["Limit"] = 10000
-	results, err := platform.RedRockQuery(client, sql, args)
+	results, err := query.Run(client, args)
 	if err != nil {
 		fmt.Printf("\nFailed to query: %v\n", err)
 	} else {
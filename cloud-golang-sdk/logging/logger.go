@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
 )
@@ -30,13 +31,21 @@ const (
 //var LogPath string
 var logger = NewLogger()
 
-// Logger represents logging object
+// Logger represents logging object. Each Logger writes to its own log file so that several
+// loggers with different settings can be used in the same process. Methods called on a nil
+// *Logger use the package level logger.
 type Logger struct {
+	mu              sync.Mutex
 	level           Level
 	logpath         string
 	errorstacktrace bool
 }
 
+// Default returns the package level logger configured by SetLevel, SetLogPath and EnableErrorStackTrace
+func Default() *Logger {
+	return logger
+}
+
 // NewLogger creates default logger
 func NewLogger() *Logger {
 	var l = new(Logger)
@@ -126,6 +135,9 @@ func ErrorTracef(format string, args ...interface{}) {
 
 // ErrorTracef records the log with stack trace in error level
 func (l *Logger) ErrorTracef(format string, args ...interface{}) {
+	if l == nil {
+		l = logger
+	}
 	if l.errorstacktrace {
 		strace := errors.New(fmt.Sprintf(format, args...))
 		l.Output(LevelError, fmt.Sprintf("%+v", strace))
@@ -136,6 +148,9 @@ func (l *Logger) ErrorTracef(format string, args ...interface{}) {
 
 // Output records the log with special callstack depth and log level.
 func (l *Logger) Output(level Level, msg string) {
+	if l == nil {
+		l = logger
+	}
 	//if l.level < level && LogLevel < level {
 	if l.level < level {
 		return
@@ -156,13 +171,18 @@ func (l *Logger) Output(level Level, msg string) {
 		fnName = strings.TrimLeft(dotName, ".") + "()"
 	}
 
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	out := log.Writer()
 	if l.logpath != "" {
 		logf, err := os.OpenFile(l.logpath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
 		if err != nil {
 			log.Println(err)
+		} else {
+			defer logf.Close()
+			out = logf
 		}
-		defer logf.Close()
-		log.SetOutput(logf)
 	}
 
 	var logLevelStr = "[UNKNOWN]"
@@ -177,5 +197,5 @@ func (l *Logger) Output(level Level, msg string) {
 		logLevelStr = "[DEBUG]"
 	}
 
-	log.Printf("%s %s:%d %s: %s", logLevelStr, filepath.Base(file), line, fnName, msg)
+	log.New(out, "", log.LstdFlags).Printf("%s %s:%d %s: %s", logLevelStr, filepath.Base(file), line, fnName, msg)
 }
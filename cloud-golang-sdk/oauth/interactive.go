@@ -0,0 +1,242 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"runtime"
+	"time"
+
+	log "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
+)
+
+// InteractiveLoginTimeout is how long interactive flows wait for user to complete login
+const InteractiveLoginTimeout = 5 * time.Minute
+
+// DeviceAuthorizationResponse represents successful device authorization response
+type DeviceAuthorizationResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// UserPrompt asks user to visit uri in browser. userCode is empty for authorization code flow.
+type UserPrompt func(uri string, userCode string) error
+
+// clientID returns client_id sent by public client. It defaults to application id.
+func (c *OauthClient) clientID(appID string) string {
+	if c.ClientID != "" {
+		return c.ClientID
+	}
+	return appID
+}
+
+// promptUser calls UserPrompt if it is set, otherwise prints the instruction and tries to open browser
+func (c *OauthClient) promptUser(uri string, userCode string) error {
+	if c.UserPrompt != nil {
+		return c.UserPrompt(uri, userCode)
+	}
+	if userCode != "" {
+		fmt.Printf("Open %s in browser and enter code %s\n", uri, userCode)
+		return nil
+	}
+	fmt.Printf("Open the following URL in browser to login:\n%s\n", uri)
+	if err := openBrowser(uri); err != nil {
+		log.Debugf("Unable to open browser: %v", err)
+	}
+	return nil
+}
+
+// openBrowser opens uri in default browser of the platform
+func openBrowser(uri string) error {
+	switch runtime.GOOS {
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", uri).Start()
+	case "darwin":
+		return exec.Command("open", uri).Start()
+	default:
+		return exec.Command("xdg-open", uri).Start()
+	}
+}
+
+// randomString returns base64url encoded random bytes
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// AuthorizationCodePKCE implements authorization code flow with PKCE for public clients. It starts a listener on
+// loopback interface to receive the redirect, asks user to login in browser and exchanges the code for token.
+func (c *OauthClient) AuthorizationCodePKCE(ctx context.Context, appID string, scope string) (*TokenResponse, error) {
+	verifier, err := randomString(32)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	state, err := randomString(16)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", c.RedirectPort))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to start redirect listener: %v", err)
+	}
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+
+	type result struct {
+		code string
+		err  error
+	}
+	results := make(chan result, 1)
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path != "/callback" {
+				http.NotFound(w, r)
+				return
+			}
+			query := r.URL.Query()
+			var res result
+			switch {
+			case query.Get("state") != state:
+				res.err = fmt.Errorf("Authorization response has invalid state")
+			case query.Get("error") != "":
+				res.err = fmt.Errorf("Authorization failed: %s %s", query.Get("error"), query.Get("error_description"))
+			case query.Get("code") == "":
+				res.err = fmt.Errorf("Authorization response has no code")
+			default:
+				res.code = query.Get("code")
+			}
+			if res.err != nil {
+				http.Error(w, res.err.Error(), http.StatusBadRequest)
+			} else {
+				fmt.Fprint(w, "Login completed. You may close this window.")
+			}
+			select {
+			case results <- res:
+			default:
+			}
+		}),
+	}
+	go server.Serve(listener)
+	defer server.Close()
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", c.clientID(appID))
+	params.Set("redirect_uri", redirectURI)
+	params.Set("scope", scope)
+	params.Set("state", state)
+	params.Set("code_challenge", challenge)
+	params.Set("code_challenge_method", "S256")
+	authURL := c.Service + "/oauth2/authorize/" + appID + "?" + params.Encode()
+
+	if err := c.promptUser(authURL, ""); err != nil {
+		return nil, err
+	}
+
+	var res result
+	select {
+	case res = <-results:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	if res.err != nil {
+		return nil, res.err
+	}
+
+	args := make(map[string]string)
+	args["grant_type"] = "authorization_code"
+	args["code"] = res.code
+	args["redirect_uri"] = redirectURI
+	args["code_verifier"] = verifier
+	args["client_id"] = c.clientID(appID)
+	token, failure, err := c.postAndGetResponse(ctx, "/oauth2/token/"+appID, args)
+	if err != nil {
+		return nil, err
+	}
+	if failure != nil {
+		return nil, fmt.Errorf("Failed to get oauth token, failure: %v", failure)
+	}
+	return token, nil
+}
+
+// DeviceCode implements device authorization flow. It shows user code and verification URI to user
+// and polls token endpoint until user completes login on another device.
+func (c *OauthClient) DeviceCode(ctx context.Context, appID string, scope string) (*TokenResponse, error) {
+	args := make(map[string]string)
+	args["client_id"] = c.clientID(appID)
+	args["scope"] = scope
+	body, status, err := c.postAndGetBody(ctx, "/oauth2/device/"+appID, args)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		failure, err := bodyToErrorResponse(body)
+		if err != nil {
+			return nil, fmt.Errorf("Device authorization failed with code %d", status)
+		}
+		return nil, fmt.Errorf("Device authorization failed, failure: %v", failure)
+	}
+	device := &DeviceAuthorizationResponse{}
+	if err := json.Unmarshal(body, device); err != nil {
+		return nil, err
+	}
+
+	if err := c.promptUser(device.VerificationURI, device.UserCode); err != nil {
+		return nil, err
+	}
+
+	interval := time.Duration(device.Interval) * time.Second
+	if interval <= 0 {
+		interval = 5 * time.Second
+	}
+	if device.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(device.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	args = make(map[string]string)
+	args["grant_type"] = "urn:ietf:params:oauth:grant-type:device_code"
+	args["device_code"] = device.DeviceCode
+	args["client_id"] = c.clientID(appID)
+	for {
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("Device authorization wasn't completed: %v", ctx.Err())
+		case <-timer.C:
+		}
+
+		token, failure, err := c.postAndGetResponse(ctx, "/oauth2/token/"+appID, args)
+		if err != nil {
+			return nil, err
+		}
+		if failure == nil {
+			return token, nil
+		}
+		switch failure.Error {
+		case "authorization_pending":
+		case "slow_down":
+			interval += 5 * time.Second
+		default:
+			return nil, fmt.Errorf("Failed to get oauth token, failure: %v", failure)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package oauth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+// standInServer mimics tenant authorization and token endpoints
+type standInServer struct {
+	mu        sync.Mutex
+	challenge string
+	redirect  string
+	polls     int
+}
+
+func (s *standInServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r.ParseForm()
+
+	switch r.URL.Path {
+	case "/oauth2/authorize/app":
+		if r.Form.Get("code_challenge_method") != "S256" || r.Form.Get("client_id") != "app" {
+			http.Error(w, "invalid request", http.StatusBadRequest)
+			return
+		}
+		s.challenge = r.Form.Get("code_challenge")
+		s.redirect = r.Form.Get("redirect_uri")
+		http.Redirect(w, r, s.redirect+"?code=authcode&state="+url.QueryEscape(r.Form.Get("state")), http.StatusFound)
+	case "/oauth2/device/app":
+		fmt.Fprint(w, `{"device_code":"devcode","user_code":"ABCD-EFGH","verification_uri":"https://tenant/device","expires_in":60,"interval":1}`)
+	case "/oauth2/token/app":
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+			if r.PostForm.Get("code") != "authcode" || r.PostForm.Get("redirect_uri") != s.redirect ||
+				base64.RawURLEncoding.EncodeToString(sum[:]) != s.challenge {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"invalid_grant"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token":"pkcetoken","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh"}`)
+		case "urn:ietf:params:oauth:grant-type:device_code":
+			s.polls++
+			if s.polls < 2 {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error":"authorization_pending"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token":"devicetoken","token_type":"Bearer","expires_in":3600}`)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newStandInClient(t *testing.T, server *httptest.Server) *OauthClient {
+	c, err := GetNewClient(server.URL, server.Client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return c
+}
+
+func TestAuthorizationCodePKCE(t *testing.T) {
+	server := httptest.NewTLSServer(&standInServer{})
+	defer server.Close()
+
+	c := newStandInClient(t, server)
+	// Browser is simulated by following the redirect back to the loopback listener
+	c.UserPrompt = func(uri string, userCode string) error {
+		go func() {
+			resp, err := server.Client().Get(uri)
+			if err != nil {
+				t.Errorf("err: %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	token, err := c.AuthorizationCodePKCE(ctx, "app", "all")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token.AccessToken != "pkcetoken" || token.RefreshToken != "refresh" {
+		t.Fatalf("unexpected token: %+v", token)
+	}
+}
+
+func TestDeviceCode(t *testing.T) {
+	stub := &standInServer{}
+	server := httptest.NewTLSServer(stub)
+	defer server.Close()
+
+	c := newStandInClient(t, server)
+	var shownCode string
+	c.UserPrompt = func(uri string, userCode string) error {
+		shownCode = userCode
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	token, err := c.DeviceCode(ctx, "app", "all")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token.AccessToken != "devicetoken" || shownCode != "ABCD-EFGH" || stub.polls != 2 {
+		t.Fatalf("unexpected token %+v, code %s after %d polls", token, shownCode, stub.polls)
+	}
+}
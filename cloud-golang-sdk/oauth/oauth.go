@@ -1,6 +1,7 @@
 package oauth
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -41,6 +42,8 @@ type OauthClient struct {
 	Scope           string
 	Token           string
 	SkipCertVerify  bool
+	RedirectPort    int        // Loopback port receiving authorization code redirect. 0 means any free port
+	UserPrompt      UserPrompt // Asks user to login in browser during interactive flows. Prints to stdout if it is nil
 }
 
 // OauthConfig represents configuration used to create Oauth clients
@@ -129,25 +132,39 @@ func (c *OauthClient) ResourceOwner(appID string, scope string, owner string, ow
 	args["username"] = owner
 	args["password"] = ownerPassword
 	args["scope"] = scope
-	return c.postAndGetResponse("/oauth2/token/"+appID, args)
+	return c.postAndGetResponse(context.Background(), "/oauth2/token/"+appID, args)
 }
 
 func (c *OauthClient) ClientCredentials(appID string, scope string) (*TokenResponse, *ErrorResponse, error) {
+	return c.ClientCredentialsWithContext(context.Background(), appID, scope)
+}
+
+// ClientCredentialsWithContext implements the client credentials flow, aborting the request when ctx is done
+func (c *OauthClient) ClientCredentialsWithContext(ctx context.Context, appID string, scope string) (*TokenResponse, *ErrorResponse, error) {
 	args := make(map[string]string)
 	args["grant_type"] = "client_credentials"
 	args["scope"] = scope
-	return c.postAndGetResponse("/oauth2/token/"+appID, args)
+	return c.postAndGetResponse(ctx, "/oauth2/token/"+appID, args)
 }
 
 func (c *OauthClient) RefreshToken(appID string, refreshToken string) (*TokenResponse, *ErrorResponse, error) {
+	return c.RefreshTokenWithContext(context.Background(), appID, refreshToken)
+}
+
+// RefreshTokenWithContext exchanges refresh token for a new access token, aborting the request when ctx is done
+func (c *OauthClient) RefreshTokenWithContext(ctx context.Context, appID string, refreshToken string) (*TokenResponse, *ErrorResponse, error) {
 	args := make(map[string]string)
 	args["grant_type"] = "refresh_token"
 	args["refresh_token"] = refreshToken
-	return c.postAndGetResponse("/oauth2/token/"+appID, args)
+	if c.ClientSecret == "" {
+		// Public client identifies itself with client_id
+		args["client_id"] = c.clientID(appID)
+	}
+	return c.postAndGetResponse(ctx, "/oauth2/token/"+appID, args)
 }
 
-func (c *OauthClient) postAndGetResponse(method string, args map[string]string) (*TokenResponse, *ErrorResponse, error) {
-	body, status, err := c.postAndGetBody(method, args)
+func (c *OauthClient) postAndGetResponse(ctx context.Context, method string, args map[string]string) (*TokenResponse, *ErrorResponse, error) {
+	body, status, err := c.postAndGetBody(ctx, method, args)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -167,9 +184,9 @@ func (c *OauthClient) postAndGetResponse(method string, args map[string]string)
 	return nil, response, nil
 }
 
-func (c *OauthClient) postAndGetBody(method string, args map[string]string) ([]byte, int, error) {
+func (c *OauthClient) postAndGetBody(ctx context.Context, method string, args map[string]string) ([]byte, int, error) {
 	postdata := strings.NewReader(payloadFromMap(args))
-	postreq, err := http.NewRequest("POST", c.Service+method, postdata)
+	postreq, err := http.NewRequestWithContext(ctx, "POST", c.Service+method, postdata)
 
 	if err != nil {
 		return nil, 0, err
@@ -1,6 +1,7 @@
 package oauth
 
 import (
+	"context"
 	"crypto/tls"
 	"fmt"
 	"net/http"
@@ -34,11 +35,39 @@ func (c *OauthClient) GetClient() (*restapi.RestClient, error) {
 		return nil, err
 	}
 
+	if c.Token == "" {
+		// Token obtained from client credentials can be renewed when it expires
+		restClient.TokenSource, err = c.NewTokenSource(token)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return restClient, nil
 }
 
 // GetOauthToken obtains OAuth token string
 func (c *OauthClient) GetOauthToken() (*TokenResponse, error) {
+	oclient, err := c.getTokenClient()
+	if err != nil {
+		return nil, err
+	}
+	token, failure, err := oclient.ClientCredentials(c.AppID, c.Scope)
+
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get confidential client token: %v", err)
+	}
+
+	if failure != nil {
+		return nil, fmt.Errorf("Failed to get oauth token, failure: %v", failure)
+	}
+
+	log.Debugf("Client token established - type: %s expires in: %d", token.TokenType, token.ExpiresIn)
+	return token, nil
+}
+
+// getTokenClient returns Oauth client used to request tokens. It authenticates with client id and secret if they are set.
+func (c *OauthClient) getTokenClient() (*OauthClient, error) {
 	//oclient, err := oauth.GetNewConfidentialClient(c.URL, c.Username, c.Password, nil)
 	var clientFactory HttpClientFactory = func() *http.Client {
 		return &http.Client{}
@@ -59,18 +88,44 @@ func (c *OauthClient) GetOauthToken() (*TokenResponse, error) {
 		return nil, fmt.Errorf("Failed to get confidential client: %v", err)
 	}
 	oclient.SourceHeader = restapi.SourceHeader
-	token, failure, err := oclient.ClientCredentials(c.AppID, c.Scope)
+	oclient.RedirectPort = c.RedirectPort
+	oclient.UserPrompt = c.UserPrompt
+	return oclient, nil
+}
+
+// GetPKCEClient logs in user with authorization code flow with PKCE and returns authenticated REST client
+func (c *OauthClient) GetPKCEClient() (*restapi.RestClient, error) {
+	return c.getInteractiveClient((*OauthClient).AuthorizationCodePKCE)
+}
+
+// GetDeviceCodeClient logs in user with device authorization flow and returns authenticated REST client
+func (c *OauthClient) GetDeviceCodeClient() (*restapi.RestClient, error) {
+	return c.getInteractiveClient((*OauthClient).DeviceCode)
+}
 
+func (c *OauthClient) getInteractiveClient(login func(*OauthClient, context.Context, string, string) (*TokenResponse, error)) (*restapi.RestClient, error) {
+	oclient, err := c.getTokenClient()
 	if err != nil {
-		return nil, fmt.Errorf("Failed to get confidential client token: %v", err)
+		return nil, err
 	}
 
-	if failure != nil {
-		return nil, fmt.Errorf("Failed to get oauth token, failure: %v", failure)
+	ctx, cancel := context.WithTimeout(context.Background(), InteractiveLoginTimeout)
+	defer cancel()
+	token, err := login(oclient, ctx, c.AppID, c.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to login: %v", err)
 	}
+	log.Debugf("User token established - type: %s expires in: %d", token.TokenType, token.ExpiresIn)
 
-	log.Debugf("Client token established - type: %s expires in: %d", token.TokenType, token.ExpiresIn)
-	return token, nil
+	restClient, err := c.GetRestClient(token)
+	if err != nil {
+		return nil, err
+	}
+	restClient.TokenSource, err = c.NewTokenSource(token)
+	if err != nil {
+		return nil, err
+	}
+	return restClient, nil
 }
 
 // GetRestClient returns rest client directly with oauth token
@@ -0,0 +1,123 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+// maxRefreshMargin is how long before expiry a token is renewed. Short lived tokens are renewed
+// when one fifth of their lifetime is left.
+const maxRefreshMargin = 60 * time.Second
+
+// tokenSource renews access token. It uses refresh token when the server issued one and falls back
+// to client credentials flow for confidential clients.
+type tokenSource struct {
+	mu           sync.Mutex
+	client       *OauthClient // client used to request tokens
+	appID        string
+	scope        string
+	token        *restapi.Token
+	refreshAt    time.Time // zero value means the token never needs renewal
+	refreshToken string
+}
+
+// NewTokenSource returns restapi.TokenSource that starts with token and renews it before it expires
+// or after it is rejected by the server. It is safe for concurrent use.
+func (c *OauthClient) NewTokenSource(token *TokenResponse) (restapi.TokenSource, error) {
+	oclient, err := c.getTokenClient()
+	if err != nil {
+		return nil, err
+	}
+	ts := &tokenSource{
+		client: oclient,
+		appID:  c.AppID,
+		scope:  c.Scope,
+	}
+	ts.setToken(token)
+	return ts, nil
+}
+
+// Token returns current token, renewing it if it is about to expire
+func (ts *tokenSource) Token(ctx context.Context) (*restapi.Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.token != nil && (ts.refreshAt.IsZero() || time.Now().Before(ts.refreshAt)) {
+		return ts.token, nil
+	}
+	if err := ts.renew(ctx); err != nil {
+		return nil, err
+	}
+	return ts.token, nil
+}
+
+// Invalidate discards token rejected by the server. Callers that got the same token concurrently
+// share a single renewal since only the current token is discarded.
+func (ts *tokenSource) Invalidate(rejected *restapi.Token) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+
+	if ts.token == rejected {
+		ts.token = nil
+	}
+}
+
+// renew obtains a new token. Caller must hold ts.mu.
+func (ts *tokenSource) renew(ctx context.Context) error {
+	if ts.refreshToken != "" {
+		token, failure, err := ts.client.RefreshTokenWithContext(ctx, ts.appID, ts.refreshToken)
+		if err == nil && failure == nil {
+			log.Debugf("Oauth token refreshed - type: %s expires in: %d", token.TokenType, token.ExpiresIn)
+			ts.setToken(token)
+			return nil
+		}
+		if failure != nil {
+			log.Infof("Failed to refresh oauth token: %v", failure)
+		} else {
+			log.Infof("Failed to refresh oauth token: %v", err)
+		}
+		ts.refreshToken = ""
+	}
+	if ts.client.ClientSecret == "" {
+		// Token of interactive login can't be renewed without user
+		return fmt.Errorf("Oauth token expired and can't be renewed, login again")
+	}
+
+	token, failure, err := ts.client.ClientCredentialsWithContext(ctx, ts.appID, ts.scope)
+	if err != nil {
+		return fmt.Errorf("Failed to get confidential client token: %w", err)
+	}
+	if failure != nil {
+		return fmt.Errorf("Failed to get oauth token, failure: %v", failure)
+	}
+	log.Debugf("Client token re-established - type: %s expires in: %d", token.TokenType, token.ExpiresIn)
+	ts.setToken(token)
+	return nil
+}
+
+// setToken stores token and calculates when it should be renewed. Caller must hold ts.mu or own ts exclusively.
+func (ts *tokenSource) setToken(token *TokenResponse) {
+	ts.token = &restapi.Token{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+	}
+	ts.refreshAt = time.Time{}
+	if token.ExpiresIn > 0 {
+		lifetime := time.Duration(token.ExpiresIn) * time.Second
+		margin := lifetime / 5
+		if margin > maxRefreshMargin {
+			margin = maxRefreshMargin
+		}
+		now := time.Now()
+		ts.token.Expiry = now.Add(lifetime)
+		ts.refreshAt = now.Add(lifetime - margin)
+	}
+	if token.RefreshToken != "" {
+		ts.refreshToken = token.RefreshToken
+	}
+}
@@ -0,0 +1,99 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+func TestTokenSourceRenewal(t *testing.T) {
+	var grants []string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		grant := r.PostForm.Get("grant_type")
+		grants = append(grants, grant)
+		if grant == "refresh_token" && r.PostForm.Get("refresh_token") == "revoked" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		fmt.Fprintf(w, `{"access_token":"token%d","token_type":"Bearer","expires_in":3600,"refresh_token":"refresh%d"}`, len(grants), len(grants))
+	}))
+	defer server.Close()
+
+	c := &OauthClient{Service: server.URL, ClientID: "client", ClientSecret: "secret", AppID: "app"}
+	ts, err := c.NewTokenSource(&TokenResponse{AccessToken: "token0", TokenType: "Bearer", ExpiresIn: 3600})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	ts.(*tokenSource).client.Client = server.Client()
+	ctx := context.Background()
+
+	// Valid token is returned without calling the server
+	token, _ := ts.Token(ctx)
+	if token.AccessToken != "token0" || len(grants) != 0 {
+		t.Fatalf("expected initial token, got %s after %d requests", token.AccessToken, len(grants))
+	}
+
+	// No refresh token was issued so client credentials are used
+	ts.Invalidate(token)
+	token, _ = ts.Token(ctx)
+	if token.AccessToken != "token1" || grants[0] != "client_credentials" {
+		t.Fatalf("expected token from client credentials, got %s via %v", token.AccessToken, grants)
+	}
+
+	// Invalidating a stale token doesn't discard the current one
+	ts.Invalidate(&restapi.Token{AccessToken: "token0"})
+	if again, _ := ts.Token(ctx); again != token {
+		t.Fatalf("expected current token to be kept")
+	}
+
+	// Refresh token issued with the last token is used next
+	ts.Invalidate(token)
+	token, _ = ts.Token(ctx)
+	if token.AccessToken != "token2" || grants[1] != "refresh_token" {
+		t.Fatalf("expected refreshed token, got %s via %v", token.AccessToken, grants)
+	}
+
+	// Rejected refresh token falls back to client credentials
+	ts.(*tokenSource).refreshToken = "revoked"
+	ts.Invalidate(token)
+	token, err = ts.Token(ctx)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token.AccessToken != "token4" || grants[3] != "client_credentials" {
+		t.Fatalf("expected fallback to client credentials, got %s via %v", token.AccessToken, grants)
+	}
+}
+
+func TestTokenSourceProactiveRenewal(t *testing.T) {
+	calls := 0
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Write([]byte(`{"access_token":"renewed","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer server.Close()
+
+	c := &OauthClient{Service: server.URL, ClientID: "client", ClientSecret: "secret", AppID: "app"}
+	// Token that expires within refresh margin is renewed before it is used
+	ts, err := c.NewTokenSource(&TokenResponse{AccessToken: "expiring", TokenType: "Bearer", ExpiresIn: 1})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	ts.(*tokenSource).client.Client = server.Client()
+
+	ts.(*tokenSource).refreshAt = ts.(*tokenSource).refreshAt.Add(-time.Second)
+	token, err := ts.Token(context.Background())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token.AccessToken != "renewed" || calls != 1 {
+		t.Fatalf("expected renewed token, got %s after %d requests", token.AccessToken, calls)
+	}
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -39,16 +38,15 @@ func NewAuthenticationProfile(c *restapi.RestClient) *AuthenticationProfile {
 }
 
 type sliceAPIResponse struct {
-	Success bool `json:"success"`
-	Result  []interface{}
-	Message string
+	restapi.BaseAPIResponse
+	Result []interface{}
 }
 
 // Read function fetches an authentication profile from source, including attribute values. Returns error if any
 func (o *AuthenticationProfile) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -57,13 +55,12 @@ func (o *AuthenticationProfile) Read() error {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -85,29 +82,28 @@ func (o *AuthenticationProfile) Create() (*restapi.GenericMapResponse, error) {
 	// Flatten chanllenges data first
 	err := o.flattenChallenges()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Flatten NumberOfQuestions
 	o.falttenNumberOfQuestions()
 	settings, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["settings"] = settings
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -120,7 +116,7 @@ func (o *AuthenticationProfile) Create() (*restapi.GenericMapResponse, error) {
 func (o *AuthenticationProfile) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -128,36 +124,35 @@ func (o *AuthenticationProfile) Update() (*restapi.GenericMapResponse, error) {
 	// Flatten chanllenges data first
 	err := o.flattenChallenges()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Flatten NumberOfQuestions
 	o.falttenNumberOfQuestions()
 	settings, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["settings"] = settings
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
 }
 
-// Query function returns a single authentication profile object
-func (o *AuthenticationProfile) Query() (map[string]interface{}, error) {
+// QueryAll returns all authentication profiles in tenant
+func (o *AuthenticationProfile) QueryAll() ([]map[string]interface{}, error) {
 	var queryArg = make(map[string]interface{})
 	args := make(map[string]interface{})
 	args["Caching"] = -1
@@ -166,25 +161,40 @@ func (o *AuthenticationProfile) Query() (map[string]interface{}, error) {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallRawAPI("/AuthProfile/GetProfileList", queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	reply := &sliceAPIResponse{}
 	err = json.Unmarshal(resp, &reply)
 	if err != nil {
-		logger.Errorf(err.Error())
-		return nil, fmt.Errorf("Failed to unmarshal sliceAPIResponse from HTTP response: %v", err)
+		o.client.Logger.Errorf(err.Error())
+		return nil, fmt.Errorf("Failed to unmarshal sliceAPIResponse from HTTP response: %w", err)
 	}
 	if !reply.Success {
-		logger.Errorf(reply.Message)
-		return nil, fmt.Errorf(reply.Message)
+		o.client.Logger.Errorf(reply.Message)
+		return nil, reply.Err()
+	}
+
+	var profiles []map[string]interface{}
+	for _, v := range reply.Result {
+		if item, ok := v.(map[string]interface{}); ok {
+			profiles = append(profiles, item)
+		}
+	}
+	return profiles, nil
+}
+
+// Query function returns a single authentication profile object
+func (o *AuthenticationProfile) Query() (map[string]interface{}, error) {
+	profiles, err := o.QueryAll()
+	if err != nil {
+		return nil, err
 	}
 
 	// This is the matched list of authentication profile. There should be only one
 	var autheProfs []keyValue
-	for _, v := range reply.Result {
-		item := v.(map[string]interface{})
+	for _, item := range profiles {
 		if item["Name"] == o.Name {
 			autheProfs = append(autheProfs, item)
 		}
@@ -192,7 +202,7 @@ func (o *AuthenticationProfile) Query() (map[string]interface{}, error) {
 
 	err = queryError(len(autheProfs))
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -207,7 +217,7 @@ func (o *AuthenticationProfile) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		return "", fmt.Errorf("Error retrieving authentication profile: %s", err)
+		return "", fmt.Errorf("Error retrieving authentication profile: %w", err)
 	}
 	o.ID = result["Uuid"].(string)
 
@@ -219,13 +229,13 @@ func (o *AuthenticationProfile) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			return fmt.Errorf("Failed to find ID of authentication profile %s. %v", o.Name, err)
+			return fmt.Errorf("Failed to find ID of authentication profile %s. %w", o.Name, err)
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -236,12 +246,12 @@ func (o *AuthenticationProfile) DeleteByName() (*restapi.GenericMapResponse, err
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			return nil, fmt.Errorf("Failed to find ID of authentication profile %s. %v", o.Name, err)
+			return nil, fmt.Errorf("Failed to find ID of authentication profile %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -45,7 +44,7 @@ func NewCloudProvider(c *restapi.RestClient) *CloudProvider {
 func (o *CloudProvider) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -55,13 +54,12 @@ func (o *CloudProvider) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -74,21 +72,20 @@ func (o *CloudProvider) Create() (*restapi.StringResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -101,28 +98,27 @@ func (o *CloudProvider) Create() (*restapi.StringResponse, error) {
 func (o *CloudProvider) Update() (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -132,7 +128,7 @@ func (o *CloudProvider) Update() (*restapi.StringResponse, error) {
 func (o *CloudProvider) Delete() (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -142,14 +138,13 @@ func (o *CloudProvider) Delete() (*restapi.StringResponse, error) {
 
 	resp, err := o.client.CallStringAPI(o.apiDelete, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -157,12 +152,12 @@ func (o *CloudProvider) Delete() (*restapi.StringResponse, error) {
 
 // Query function returns a single CloudProvider object in map format
 func (o *CloudProvider) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM CloudProviders WHERE 1=1"
+	query := NewQueryBuilder("CloudProviders")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.CloudAccountID != "" {
-		query += " AND CloudAccountId='" + o.CloudAccountID + "'"
+		query.Equal("CloudAccountId", o.CloudAccountID)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -179,9 +174,8 @@ func (o *CloudProvider) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		errmsg := fmt.Sprintf("Error retrieving cloud provider: %s", err)
-		logger.Errorf(errmsg)
-		return "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving cloud provider: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -193,13 +187,13 @@ func (o *CloudProvider) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			return fmt.Errorf("Failed to find ID of cloud provider %s. %v", o.Name, err)
+			return fmt.Errorf("Failed to find ID of cloud provider %s. %w", o.Name, err)
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -210,12 +204,12 @@ func (o *CloudProvider) DeleteByName() (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			return nil, fmt.Errorf("Failed to find ID of cloud provider %s. %v", o.Name, err)
+			return nil, fmt.Errorf("Failed to find ID of cloud provider %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
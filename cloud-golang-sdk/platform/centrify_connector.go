@@ -3,7 +3,6 @@ package platform
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -54,34 +53,34 @@ func (o *Connector) Update() (*restapi.GenericMapResponse, error) {
 
 // Query function returns a single Connector object in map format
 func (o *Connector) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Proxy WHERE 1=1"
+	query := NewQueryBuilder("Proxy")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.Status != "" {
 		if o.Status == "Active" {
-			query += " AND Online=1"
+			query.Equal("Online", true)
 		} else {
-			query += " AND Online=0"
+			query.Equal("Online", false)
 		}
 	}
 	if o.Version != "" {
-		query += " AND Version='" + o.Version + "'"
+		query.Equal("Version", o.Version)
 	}
 	if o.VpcIdentifier != "" {
-		query += " AND VpcIdentifier='" + o.VpcIdentifier + "'"
+		query.Equal("VpcIdentifier", o.VpcIdentifier)
 	}
-	if o.VpcIdentifier != "" {
-		query += " AND VmIdentifier='" + o.VmIdentifier + "'"
+	if o.VmIdentifier != "" {
+		query.Equal("VmIdentifier", o.VmIdentifier)
 	}
 	if o.MachineName != "" {
-		query += " AND MachineName='" + o.MachineName + "'"
+		query.Equal("MachineName", o.MachineName)
 	}
 	if o.DnsHostName != "" {
-		query += " AND DnsHostName='" + o.DnsHostName + "'"
+		query.Equal("DnsHostName", o.DnsHostName)
 	}
 	if o.Forest != "" {
-		query += " AND Forest='" + o.Forest + "'"
+		query.Equal("Forest", o.Forest)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -95,8 +94,8 @@ func (o *Connector) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -111,8 +110,8 @@ func (o *Connector) GetByName() error {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	mapToStruct(o, result)
 
@@ -8,7 +8,6 @@ import (
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/desktopapp/cmdparamtype"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/resourcetype"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -63,7 +62,7 @@ func NewDesktopApp(c *restapi.RestClient) *DesktopApp {
 func (o *DesktopApp) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -73,14 +72,13 @@ func (o *DesktopApp) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -98,23 +96,22 @@ func (o *DesktopApp) Create() (*restapi.SliceResponse, error) {
 
 	err = o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg["ID"] = []string{o.TemplateName}
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallSliceAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -127,7 +124,7 @@ func (o *DesktopApp) Create() (*restapi.SliceResponse, error) {
 func (o *DesktopApp) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -139,29 +136,28 @@ func (o *DesktopApp) Update() (*restapi.GenericMapResponse, error) {
 
 	err = o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -171,7 +167,7 @@ func (o *DesktopApp) Update() (*restapi.GenericMapResponse, error) {
 func (o *DesktopApp) Delete() (*restapi.SliceResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -179,23 +175,22 @@ func (o *DesktopApp) Delete() (*restapi.SliceResponse, error) {
 
 	resp, err := o.client.CallSliceAPI(o.apiDelete, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 	return resp, nil
 }
 
 // Query function returns a single DesktopApp object in map format
 func (o *DesktopApp) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Application WHERE 1=1 AND AppType='Desktop'"
+	query := NewQueryBuilder("Application").Equal("AppType", "Desktop")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -209,8 +204,8 @@ func (o *DesktopApp) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -222,8 +217,8 @@ func (o *DesktopApp) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("failed to find ID of %s %s. %v", GetVarType(o), o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("failed to find ID of %s %s. %w", GetVarType(o), o.Name, err)
 		}
 	}
 
@@ -239,8 +234,8 @@ func (o *DesktopApp) DeleteByName() (*restapi.SliceResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("failed to find ID of DesktopApp %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("failed to find ID of DesktopApp %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -255,19 +250,19 @@ func (o *DesktopApp) resolveIDs() error {
 	var err error
 	err = o.resolveApplicationHostID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	err = o.resolveApplicationRunAccountID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	err = o.resolveTargetObjectID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
@@ -282,7 +277,7 @@ func (o *DesktopApp) resolveApplicationHostID() error {
 		var err error
 		o.DesktopAppRunHostID, err = system.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return fmt.Errorf(err.Error())
 		}
 	}
@@ -313,7 +308,7 @@ func (o *DesktopApp) resolveApplicationRunAccountID() error {
 
 		o.DesktopAppRunAccountID, err = account.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return fmt.Errorf(err.Error())
 		}
 	}
@@ -371,7 +366,7 @@ func (o *DesktopApp) resolveTargetObjectID() error {
 			}
 
 			if err != nil {
-				logger.Errorf(err.Error())
+				o.client.Logger.Errorf(err.Error())
 				return fmt.Errorf(err.Error())
 			}
 
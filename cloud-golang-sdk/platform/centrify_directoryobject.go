@@ -57,7 +57,7 @@ func (o *DirectoryObjects) Read() error {
 		return err
 	}
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return resp.Err()
 	}
 
 	var rs map[string]interface{}
@@ -87,11 +87,11 @@ func (o *DirectoryObjects) GetByName(objType string, name string, dir DirectoryS
 	o.DirectoryServices = []string{dir.ID}
 	err := o.Read()
 	if err != nil {
-		return nil, fmt.Errorf("error retrieving directory services: %s", err)
+		return nil, fmt.Errorf("error retrieving directory services: %w", err)
 	}
 
 	if len(o.DirectoryObjects) == 0 {
-		return nil, fmt.Errorf("query returns 0 object for directory object %s", name)
+		return nil, restapi.NewNotFoundError("query returns 0 object for directory object %s", name)
 	}
 	if len(o.DirectoryObjects) > 1 {
 		return nil, fmt.Errorf("search directory object %s, but returns too many objects (found %d, expected 1)", name, len(o.DirectoryObjects))
@@ -1,7 +1,6 @@
 package platform
 
 import (
-	"errors"
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/directoryservice"
@@ -49,7 +48,7 @@ func (o *DirectoryServices) GetDirectorServices() ([]map[string]interface{}, err
 		return nil, err
 	}
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, resp.Err()
 	}
 
 	var results = resp.Result["Results"].([]interface{})
@@ -82,7 +81,7 @@ func (o *DirectoryServices) Read() error {
 func (o *DirectoryServices) GetByName(service string, name string) (*DirectoryService, error) {
 	err := o.Read()
 	if err != nil {
-		return nil, fmt.Errorf("Error retrieving directory services: %s", err)
+		return nil, fmt.Errorf("Error retrieving directory services: %w", err)
 	}
 
 	var dirtype string
@@ -106,7 +105,7 @@ func (o *DirectoryServices) GetByName(service string, name string) (*DirectorySe
 		}
 	}
 	if len(dirs) == 0 {
-		return nil, errors.New("Query returns 0 object")
+		return nil, restapi.NewNotFoundError("Query returns 0 object")
 	}
 	if len(dirs) > 1 {
 		return nil, fmt.Errorf("Query returns too many objects (found %d, expected 1)", len(dirs))
@@ -0,0 +1,175 @@
+package platform
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+// DynamicSet - Encapsulates a single dynamic set whose members are objects returned by RedRock query.
+// Permissions and member permissions are handled the same way as ManualSet
+type DynamicSet struct {
+	ManualSet
+	SQL string `json:"SQL,omitempty" schema:"query,omitempty"`
+}
+
+// fromTablePattern matches table of FROM clause, e.g. FROM Server or FROM [Server]
+var fromTablePattern = regexp.MustCompile(`(?i)\bFROM\s+\[?([A-Za-z_][A-Za-z0-9_]*)\]?`)
+
+// NewDynamicSet is a DynamicSet constructor
+func NewDynamicSet(c *restapi.RestClient) *DynamicSet {
+	s := DynamicSet{}
+	s.ManualSet = *NewManualSet(c)
+	s.CollectionType = "SqlDynamic"
+	s.apiCreate = "/Collection/CreateDynamicCollection"
+
+	return &s
+}
+
+// NewDynamicSetWithType is another DynamicSet constructor that initialise memberpermissions api endpiont
+func NewDynamicSetWithType(c *restapi.RestClient, setType string) (*DynamicSet, error) {
+	s := NewDynamicSet(c)
+	s.ObjectType = setType
+	err := s.ResolveValidMemberPerms()
+	if err != nil {
+		c.Logger.Errorf(err.Error())
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ValidateDynamicSetQuery checks that query is a single SELECT statement on table of set type, e.g. Server
+func ValidateDynamicSetQuery(setType string, query string) error {
+	script, err := PrepareScript(query, nil)
+	if err != nil {
+		return err
+	}
+	match := fromTablePattern.FindStringSubmatch(script)
+	if match == nil {
+		return fmt.Errorf("Query of dynamic set must select from %s table", setType)
+	}
+	if setType != "" && !strings.EqualFold(match[1], setType) {
+		return fmt.Errorf("Query of dynamic set of type %s must select from %s table instead of %s", setType, setType, match[1])
+	}
+
+	return nil
+}
+
+// Read function fetches a DynamicSet from source, including attribute values. Returns error if any
+func (o *DynamicSet) Read() error {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["ID"] = o.ID
+
+	// Attempt to read from an upstream API
+	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
+	}
+
+	mapToStruct(o, resp.Result)
+	if o.CollectionType != "SqlDynamic" {
+		err := fmt.Errorf("Set %s is a %s set, not a dynamic set", o.Name, o.CollectionType)
+		o.client.Logger.Errorf(err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// Create function creates a new DynamicSet and returns a map that contains creation result
+func (o *DynamicSet) Create() (*restapi.StringResponse, error) {
+	if err := ValidateDynamicSetQuery(o.ObjectType, o.SQL); err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	queryArg, err := generateRequestMap(o)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
+
+	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
+	}
+
+	// Assign ID after successful creation so that the same object can be used for subsequent update operation
+	o.ID = resp.Result
+
+	return resp, nil
+}
+
+// Update function updates an existing DynamicSet and returns a map that contains update result
+func (o *DynamicSet) Update() (*restapi.GenericMapResponse, error) {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return nil, fmt.Errorf(errormsg)
+	}
+	if err := ValidateDynamicSetQuery(o.ObjectType, o.SQL); err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	queryArg, err := generateRequestMap(o)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
+
+	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
+	}
+
+	return resp, nil
+}
+
+// UpdateSetMembers isn't supported since members of DynamicSet are defined by its query
+func (o *DynamicSet) UpdateSetMembers(ids []string, action string) (*restapi.StringResponse, error) {
+	return nil, fmt.Errorf("Members of dynamic set %s are defined by its query and can't be updated", o.Name)
+}
+
+// GetByName retrieves dynamic set from tenant by name
+func (o *DynamicSet) GetByName() error {
+	if o.ID == "" {
+		_, err := o.GetIDByName()
+		if err != nil {
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of set %s. %w", o.Name, err)
+		}
+	}
+
+	err := o.Read()
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return err
+	}
+	return nil
+}
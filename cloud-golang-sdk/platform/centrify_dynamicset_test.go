@@ -0,0 +1,55 @@
+package platform
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+func TestDynamicSetCreate(t *testing.T) {
+	var path string
+	var args map[string]interface{}
+	server := recordingServer(t, `"set-1"`, &path, &args, "/Collection/CreateDynamicCollection")
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	set, err := NewDynamicSetWithType(client, "Server")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set.Name = "Web Servers"
+	set.SQL = "SELECT ID FROM Server WHERE Name LIKE 'web%'"
+	if _, err := set.Create(); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/Collection/CreateDynamicCollection" || args["CollectionType"] != "SqlDynamic" || args["ObjectType"] != "Server" || args["SQL"] != set.SQL {
+		t.Errorf("unexpected request %s %v", path, args)
+	}
+	if set.ID != "set-1" {
+		t.Errorf("expected ID set-1, got %s", set.ID)
+	}
+
+	path = ""
+	set.SQL = "SELECT ID FROM VaultAccount"
+	if _, err := set.Update(); err == nil || path != "" {
+		t.Errorf("expected query on other table to be rejected before calling API, got %v %s", err, path)
+	}
+	if _, err := set.UpdateSetMembers([]string{"system-1"}, "add"); err == nil {
+		t.Errorf("expected members of dynamic set not to be updatable")
+	}
+}
+
+func TestDynamicSetReadRejectsOtherSets(t *testing.T) {
+	var path string
+	var args map[string]interface{}
+	server := recordingServer(t, `{"ID":"set-1","Name":"Linux Servers","ObjectType":"Server","CollectionType":"ManualBucket"}`, &path, &args, "/Collection/GetCollection")
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	set := NewDynamicSet(client)
+	set.ID = "set-1"
+	if err := set.Read(); err == nil || !strings.Contains(err.Error(), "not a dynamic set") {
+		t.Errorf("expected manual set to be rejected, got %v", err)
+	}
+}
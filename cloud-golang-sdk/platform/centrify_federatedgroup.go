@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/directoryservice"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -38,14 +37,14 @@ func NewFederatedGroup(c *restapi.RestClient) *FederatedGroup {
 func (o *FederatedGroup) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	// Get Federated Directory Service
 	ds := NewDirectoryServices(o.client)
 	theds, err := ds.GetByName(directoryservice.FederatedDirectory.String(), "Federated Directory Service")
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
@@ -57,13 +56,12 @@ func (o *FederatedGroup) Read() error {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	rs := resp.Result["Group"].(map[string]interface{})
@@ -88,13 +86,12 @@ func (o *FederatedGroup) Create() (string, error) {
 		queryArg["TempAttributeNameFromSDK_DoNotUse"] = o.Name
 		resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return "", err
 		}
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return "", fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return "", resp.Err()
 		}
 	*/
 	// After successful creation of global group mapping, get group ID
@@ -102,20 +99,19 @@ func (o *FederatedGroup) Create() (string, error) {
 	fedgrp.Name = o.Name
 	id, err := fedgrp.GetIDByName()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 	/*
 		// Since we create a global group mapping in order to create the federated group, we need to delete the mapping
 		resp, err = o.client.CallStringAPI(o.apiDelete, queryArg)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return "", err
 		}
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return "", fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return "", resp.Err()
 		}
 	*/
 	return id, nil
@@ -130,7 +126,7 @@ func (o *FederatedGroup) GetIDByName() (string, error) {
 	ds := NewDirectoryServices(o.client)
 	theds, err := ds.GetByName(directoryservice.FederatedDirectory.String(), "Federated Directory Service")
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 
@@ -142,19 +138,18 @@ func (o *FederatedGroup) GetIDByName() (string, error) {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return "", resp.Err()
 	}
 
 	rs := resp.Result["Group"].(map[string]interface{})
 	var results = rs["Results"].([]interface{})
 	if len(results) == 0 {
-		return "", fmt.Errorf("Query returns 0 federated group")
+		return "", restapi.NewNotFoundError("Query returns 0 federated group")
 	}
 	// There could be more than one groups returned because query uses "like" operator
 	var row map[string]interface{}
@@ -173,7 +168,7 @@ func (o *FederatedGroup) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			return fmt.Errorf("Failed to find ID of federated group %s. %v", o.Name, err)
+			return fmt.Errorf("Failed to find ID of federated group %s. %w", o.Name, err)
 		}
 	}
 
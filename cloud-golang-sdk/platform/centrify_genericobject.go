@@ -2,8 +2,8 @@ package platform
 
 import (
 	"fmt"
+	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -113,7 +113,7 @@ type ZoneRole struct {
 func (o *vaultObject) deleteObjectBoolAPI(idfield string) (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var funcArg = make(map[string]interface{})
@@ -125,14 +125,13 @@ func (o *vaultObject) deleteObjectBoolAPI(idfield string) (*restapi.BoolResponse
 
 	resp, err := o.client.CallBoolAPI(o.apiDelete, funcArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -142,7 +141,7 @@ func (o *vaultObject) deleteObjectBoolAPI(idfield string) (*restapi.BoolResponse
 func (o *vaultObject) deleteObjectMapAPI(idfield string) (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var funcArg = make(map[string]interface{})
@@ -158,7 +157,7 @@ func (o *vaultObject) deleteObjectMapAPI(idfield string) (*restapi.GenericMapRes
 	}
 
 	if !reply.Success {
-		return nil, fmt.Errorf(reply.Message)
+		return nil, reply.Err()
 	}
 
 	return reply, nil
@@ -168,7 +167,7 @@ func (o *vaultObject) deleteObjectMapAPI(idfield string) (*restapi.GenericMapRes
 func (o *vaultObject) deleteObjectStringAPI(idfield string) (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var funcArg = make(map[string]interface{})
@@ -184,7 +183,7 @@ func (o *vaultObject) deleteObjectStringAPI(idfield string) (*restapi.StringResp
 	}
 
 	if !reply.Success {
-		return nil, fmt.Errorf(reply.Message)
+		return nil, reply.Err()
 	}
 
 	return reply, nil
@@ -194,7 +193,7 @@ func (o *vaultObject) deleteObjectStringAPI(idfield string) (*restapi.StringResp
 func (o *vaultObject) SetPermissions(isRemove bool) (*restapi.BaseAPIResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -216,24 +215,136 @@ func (o *vaultObject) SetPermissions(isRemove bool) (*restapi.BaseAPIResponse, e
 		queryArg["ID"] = o.ID
 		queryArg["PVID"] = o.ID
 		queryArg["Grants"] = permissions
-		logger.Debugf("Generated Map for SetPermissions(): %+v", queryArg)
+		o.client.Logger.Debugf("Generated Map for SetPermissions(): %+v", queryArg)
 		resp, err := o.client.CallBaseAPI(o.apiPermissions, queryArg)
 		if err != nil {
 			return nil, err
 		}
 		if !resp.Success {
-			return nil, fmt.Errorf(fmt.Sprintf("%s %s", resp.Message, resp.Exception))
+			return nil, resp.Err()
 		}
 		return resp, nil
 	}
 	return nil, nil
 }
 
+// GetPermissions fetches permissions granted directly on the object. Rights are returned as API names such as Owner or Naked
+func (o *vaultObject) GetPermissions() ([]Permission, error) {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return nil, fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["RowKey"] = o.ID
+	queryArg["Table"] = o.SetType
+	queryArg["ReduceSysadmin"] = true
+
+	return getAces(o.client, "/Acl/GetRowAces", queryArg)
+}
+
+// getAces calls ACL API and converts returned access control entries into permissions. Inherited entries are skipped
+func getAces(c *restapi.RestClient, api string, queryArg map[string]interface{}) ([]Permission, error) {
+	c.Logger.Debugf("Generated Map for getAces(): %+v", queryArg)
+	resp, err := c.CallSliceAPI(api, queryArg)
+	if err != nil {
+		c.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	if !resp.Success {
+		c.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
+	}
+
+	var permissions []Permission
+	for _, r := range resp.Result {
+		ace, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if inherited, _ := ace["Inherited"].(bool); inherited {
+			continue
+		}
+		if inherited, _ := ace["IsInherited"].(bool); inherited {
+			continue
+		}
+		permission := Permission{
+			PrincipalID:   aceString(ace, "PrincipalId"),
+			PrincipalName: aceString(ace, "PrincipalName", "Principal"),
+			PrincipalType: aceString(ace, "PrincipalType", "Type"),
+			Rights:        aceString(ace, "GrantStr"),
+		}
+		for _, right := range strings.Split(permission.Rights, ",") {
+			if right = strings.TrimSpace(right); right != "" {
+				permission.RightList = append(permission.RightList, right)
+			}
+		}
+		if permission.PrincipalID == "" || len(permission.RightList) == 0 {
+			continue
+		}
+		permissions = append(permissions, permission)
+	}
+
+	return permissions, nil
+}
+
+// aceString returns value of the first key that exists in access control entry
+func aceString(ace map[string]interface{}, keys ...string) string {
+	for _, k := range keys {
+		if v, ok := ace[k].(string); ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// GetSets fetches IDs of manual sets that the object is member of
+func (o *vaultObject) GetSets() ([]string, error) {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return nil, fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["ID"] = o.ID
+	queryArg["ObjectType"] = o.SetType
+	queryArg["CollectionType"] = "ManualBucket"
+	queryArg["Args"] = subArgs
+
+	resp, err := o.client.CallGenericMapAPI("/Collection/GetObjectCollectionsAndFilters", queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
+	}
+
+	var sets []string
+	results, _ := resp.Result["Results"].([]interface{})
+	for _, r := range results {
+		row, _ := r.(map[string]interface{})["Row"].(map[string]interface{})
+		if row == nil {
+			continue
+		}
+		// Dynamic sets are returned as well if API ignores CollectionType filter
+		if t, ok := row["CollectionType"].(string); ok && t != "ManualBucket" {
+			continue
+		}
+		if id, ok := row["ID"].(string); ok && id != "" {
+			sets = append(sets, id)
+		}
+	}
+
+	return sets, nil
+}
+
 // FillStruct function fills a struct with map
 func (o *vaultObject) FillStruct(m map[string]interface{}) error {
-	logger.Debugf("Input map: %v", m)
+	o.client.Logger.Debugf("Input map: %v", m)
 	for k, v := range m {
-		logger.Debugf("Map key %v, map value: %v", k, v)
+		o.client.Logger.Debugf("Map key %v, map value: %v", k, v)
 		err := setField(o, k, v)
 		if err != nil {
 			return err
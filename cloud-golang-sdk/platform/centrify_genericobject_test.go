@@ -0,0 +1,108 @@
+package platform
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+)
+
+func TestConvertFromValidList(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    []string
+		valid    map[string]string
+		expected []string
+	}{
+		{"account", []string{"Owner", "Naked", "Manage", "UserPortalLogin", "View"}, ValidPermissionMap.Account, []string{"Checkout", "Edit", "Grant", "View", "WorkspaceLogin"}},
+		{"winnix", []string{"ManagePrivilegeElevationAssignment"}, ValidPermissionMap.WinNix, []string{"ManagementAssignment"}},
+		{"sshkey", []string{"Checkout"}, ValidPermissionMap.SSHKey, []string{"Retrieve"}},
+		{"case and spaces", []string{" naked", "", "view "}, ValidPermissionMap.Account, []string{"Checkout", "View"}},
+		{"unknown right", []string{"Naked", "Grant"}, ValidPermissionMap.Generic, []string{"Grant", "Naked"}},
+	}
+	for _, tc := range cases {
+		if out := ConvertFromValidList(tc.input, tc.valid); !reflect.DeepEqual(out, tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, out)
+		}
+	}
+}
+
+// recordingServer serves result for requests to paths and records path and arguments of the last one
+func recordingServer(t *testing.T, result string, path *string, args *map[string]interface{}, paths ...string) *httptest.Server {
+	handlers := make(map[string]restapitest.Handler)
+	for _, p := range paths {
+		handlers[p] = func(r *restapitest.Request) interface{} {
+			*path = r.URL.Path
+			*args = r.Args
+			return json.RawMessage(result)
+		}
+	}
+	return restapitest.NewServer(t, handlers)
+}
+
+func TestGetPermissions(t *testing.T) {
+	var path string
+	var args map[string]interface{}
+	server := recordingServer(t, `[
+		{"PrincipalId":"u1","Principal":"admin@example.com","PrincipalType":"User","GrantStr":"Owner, View,Naked"},
+		{"PrincipalId":"r1","PrincipalName":"Auditors","Type":"Role","GrantStr":"View"},
+		{"PrincipalId":"r2","PrincipalName":"Inherited","PrincipalType":"Role","GrantStr":"View","Inherited":true},
+		{"PrincipalId":"r3","PrincipalName":"Nothing","PrincipalType":"Role","GrantStr":""}
+	]`, &path, &args, "/Acl/GetRowAces", "/Acl/GetCollectionAces")
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	account := NewAccount(client)
+	account.ID = "account-1"
+	permissions, err := account.GetPermissions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/Acl/GetRowAces" || args["RowKey"] != "account-1" || args["Table"] != "VaultAccount" {
+		t.Errorf("unexpected request %s %v", path, args)
+	}
+	expected := []Permission{
+		{PrincipalID: "u1", PrincipalName: "admin@example.com", PrincipalType: "User", Rights: "Owner, View,Naked", RightList: []string{"Owner", "View", "Naked"}},
+		{PrincipalID: "r1", PrincipalName: "Auditors", PrincipalType: "Role", Rights: "View", RightList: []string{"View"}},
+	}
+	if !reflect.DeepEqual(permissions, expected) {
+		t.Errorf("expected %+v, got %+v", expected, permissions)
+	}
+
+	set := NewManualSet(client)
+	set.ID = "set-1"
+	if _, err := set.GetPermissions(); err != nil {
+		t.Fatal(err)
+	}
+	if path != "/Acl/GetCollectionAces" || args["RowKey"] != "set-1" {
+		t.Errorf("unexpected request %s %v", path, args)
+	}
+}
+
+func TestGetSets(t *testing.T) {
+	var path string
+	var args map[string]interface{}
+	server := recordingServer(t, `{"Results":[
+		{"Row":{"ID":"set-1","CollectionType":"ManualBucket"}},
+		{"Row":{"ID":"set-2","CollectionType":"SqlDynamic"}},
+		{"Row":{"ID":"set-3"}}
+	]}`, &path, &args, "/Collection/GetObjectCollectionsAndFilters")
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	system := NewSystem(client)
+	system.ID = "system-1"
+	sets, err := system.GetSets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/Collection/GetObjectCollectionsAndFilters" || args["ID"] != "system-1" || args["ObjectType"] != "Server" {
+		t.Errorf("unexpected request %s %v", path, args)
+	}
+	if expected := []string{"set-1", "set-3"}; !reflect.DeepEqual(sets, expected) {
+		t.Errorf("expected %v, got %v", expected, sets)
+	}
+}
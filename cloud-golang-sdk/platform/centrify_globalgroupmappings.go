@@ -1,9 +1,6 @@
 package platform
 
 import (
-	"fmt"
-
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -44,13 +41,12 @@ func (o *GroupMappings) Read() error {
 	queryArg["Args"] = subArgs
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -80,17 +76,16 @@ func (o *GroupMappings) createOrDelete(api string) error {
 	for _, v := range o.Mappings {
 		var queryArg = make(map[string]interface{})
 		queryArg[v.AttributeValue] = v.GroupName
-		logger.Debugf("Generated Map for %s: %+v", api, queryArg)
+		o.client.Logger.Debugf("Generated Map for %s: %+v", api, queryArg)
 
 		resp, err := o.client.CallStringAPI(api, queryArg)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return resp.Err()
 		}
 	}
 	return nil
@@ -109,15 +104,14 @@ func (o *GroupMappings) Update() error {
 	queryArg["Mappings"] = mappings
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdates, queryArg)
-	logger.Debugf("Generated Map for %s: %+v", o.apiUpdates, queryArg)
+	o.client.Logger.Debugf("Generated Map for %s: %+v", o.apiUpdates, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	return nil
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/workflowtype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -36,7 +35,7 @@ func NewGlobalWorkflow(c *restapi.RestClient, wfType string) (*GlobalWorkflow, e
 	if wfType != workflowtype.AccountWorkflow.String() && wfType != workflowtype.AgentAuthWorkflow.String() &&
 		wfType != workflowtype.SecretsWorkflow.String() && wfType != workflowtype.PrivilegeElevationWorkflow.String() {
 		errormsg := fmt.Sprintf("invalid workflow type %s", wfType)
-		logger.Errorf(errormsg)
+		c.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -49,13 +48,12 @@ func (o *GlobalWorkflow) Read() error {
 	queryArg["key"] = o.Type
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	wfSettings := &GlobalWorkflowSetting{}
@@ -74,7 +72,6 @@ func (o *GlobalWorkflow) Update() (*restapi.GenericMapResponse, error) {
 		// Due to historical reason, Approvers attribute is not in json format rather it is in string so need to perform conversion
 		// Convert approvers from struct to string so that it can be assigned to the actual attribute used for privision.
 		o.Settings.Approvers = FlattenWorkflowApprovers(o.Settings.ApproverList)
-		//logger.Debugf("Converted approvers: %+v", o.Settings.Approvers)
 
 		if o.Settings.DefaultOptions == "" {
 			o.Settings.DefaultOptions = "{\"GrantMin\":60}"
@@ -84,21 +81,20 @@ func (o *GlobalWorkflow) Update() (*restapi.GenericMapResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -111,21 +107,20 @@ func (o *GlobalWorkflow) Delete() error {
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	return nil
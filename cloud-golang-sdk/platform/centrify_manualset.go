@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -12,6 +11,7 @@ import (
 type ManualSet struct {
 	vaultObject
 	apiUpdateMembers       string
+	apiGetMembers          string
 	apiMemberPermissions   string
 	ValidMemberPermissions map[string]string
 
@@ -39,6 +39,7 @@ func NewManualSet(c *restapi.RestClient) *ManualSet {
 	s.apiDelete = "/Collection/DeleteCollection"
 	s.apiUpdate = "/Collection/UpdateCollection"
 	s.apiUpdateMembers = "/Collection/UpdateMembersCollection"
+	s.apiGetMembers = "/Collection/GetMembers"
 	s.apiPermissions = "/Collection/SetCollectionPermissions"
 
 	return &s
@@ -50,7 +51,7 @@ func NewManualSetWithType(c *restapi.RestClient, setType string) (*ManualSet, er
 	s.ObjectType = setType
 	err := s.ResolveValidMemberPerms()
 	if err != nil {
-		logger.Errorf(err.Error())
+		c.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -61,7 +62,7 @@ func NewManualSetWithType(c *restapi.RestClient, setType string) (*ManualSet, er
 func (o *ManualSet) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -71,14 +72,13 @@ func (o *ManualSet) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -92,21 +92,20 @@ func (o *ManualSet) Create() (*restapi.StringResponse, error) {
 	// If SubObjectType is not set, the set will be visible for both Web and Desktop applications
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -124,27 +123,26 @@ func (o *ManualSet) Delete() (*restapi.GenericMapResponse, error) {
 func (o *ManualSet) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -152,15 +150,15 @@ func (o *ManualSet) Update() (*restapi.GenericMapResponse, error) {
 
 // Query function returns a single Set object in map format
 func (o *ManualSet) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Sets WHERE 1=1"
+	query := NewQueryBuilder("Sets")
 	if o.ObjectType != "" {
-		query += " AND ObjectType='" + o.ObjectType + "'"
+		query.Equal("ObjectType", o.ObjectType)
 	}
 	if o.CollectionType != "" {
-		query += " AND CollectionType='" + o.CollectionType + "'"
+		query.Equal("CollectionType", o.CollectionType)
 	}
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -172,7 +170,7 @@ func (o *ManualSet) UpdateSetMembers(ids []string, action string) (*restapi.Stri
 	var err error
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	if action != "add" && action != "remove" {
@@ -195,24 +193,71 @@ func (o *ManualSet) UpdateSetMembers(ids []string, action string) (*restapi.Stri
 		queryArg[action] = members
 		resp, err = o.client.CallStringAPI(o.apiUpdateMembers, queryArg)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return nil, err
 		}
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return nil, fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return nil, resp.Err()
 		}
 	}
 
 	return resp, nil
 }
 
+// GetSetMembers returns IDs of members of the ManualSet
+func (o *ManualSet) GetSetMembers() ([]string, error) {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return nil, fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["ID"] = o.ID
+
+	resp, err := o.client.CallSliceAPI(o.apiGetMembers, queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
+	}
+
+	var ids []string
+	for _, r := range resp.Result {
+		member, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if key, ok := member["Key"].(string); ok && key != "" {
+			ids = append(ids, key)
+		}
+	}
+
+	return ids, nil
+}
+
+// GetPermissions fetches permissions granted on the set itself. Member permissions aren't included
+func (o *ManualSet) GetPermissions() ([]Permission, error) {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return nil, fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["RowKey"] = o.ID
+	queryArg["ReduceSysadmin"] = true
+
+	return getAces(o.client, "/Acl/GetCollectionAces", queryArg)
+}
+
 // SetMemberPermissions sets member permissions. isRemove indicates whether to remove all permissions instead of setting permissions
 func (o *ManualSet) SetMemberPermissions(isRemove bool) (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -235,16 +280,15 @@ func (o *ManualSet) SetMemberPermissions(isRemove bool) (*restapi.GenericMapResp
 		queryArg["PVID"] = o.ID
 		queryArg["RowKey"] = o.ID
 		queryArg["Grants"] = permissions
-		logger.Debugf("Generated Map for SetMemberPermissions(): %+v", queryArg)
+		o.client.Logger.Debugf("Generated Map for SetMemberPermissions(): %+v", queryArg)
 		resp, err := o.client.CallGenericMapAPI(o.apiMemberPermissions, queryArg)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return nil, err
 		}
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return nil, fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return nil, resp.Err()
 		}
 		return resp, nil
 	}
@@ -302,8 +346,8 @@ func (o *ManualSet) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving set: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving set: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -315,14 +359,14 @@ func (o *ManualSet) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of set %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of set %s. %w", o.Name, err)
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -333,13 +377,13 @@ func (o *ManualSet) DeleteByName() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of Set %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of Set %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/resourcetype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -41,7 +40,7 @@ func NewMultiplexedAccount(c *restapi.RestClient) *MultiplexedAccount {
 func (o *MultiplexedAccount) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -51,14 +50,13 @@ func (o *MultiplexedAccount) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -80,20 +78,19 @@ func (o *MultiplexedAccount) Create() (*restapi.StringResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -111,7 +108,7 @@ func (o *MultiplexedAccount) Delete() (*restapi.GenericMapResponse, error) {
 func (o *MultiplexedAccount) Update() (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -123,20 +120,19 @@ func (o *MultiplexedAccount) Update() (*restapi.StringResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 	resp, err := o.client.CallStringAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -144,9 +140,9 @@ func (o *MultiplexedAccount) Update() (*restapi.StringResponse, error) {
 
 // Query function returns a single MultiplexedAccount object in map format
 func (o *MultiplexedAccount) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM MultiplexedAccount WHERE 1=1"
+	query := NewQueryBuilder("MultiplexedAccount")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -160,8 +156,8 @@ func (o *MultiplexedAccount) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving MultiplexedAccount: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving MultiplexedAccount: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -173,8 +169,8 @@ func (o *MultiplexedAccount) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of MultiplexedAccount %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of MultiplexedAccount %s. %w", o.Name, err)
 		}
 	}
 
@@ -190,8 +186,8 @@ func (o *MultiplexedAccount) DeleteByName() (*restapi.GenericMapResponse, error)
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of MultiplexedAccount %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of MultiplexedAccount %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -227,7 +223,7 @@ func (o *MultiplexedAccount) resolveAccountID() error {
 
 			acctid, err := account.GetIDByName()
 			if err != nil {
-				logger.Errorf(err.Error())
+				o.client.Logger.Errorf(err.Error())
 				return fmt.Errorf(err.Error())
 			}
 			o.RealAccounts = append(o.RealAccounts, acctid)
@@ -3,7 +3,6 @@ package platform
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -44,7 +43,7 @@ func NewPasswordProfile(c *restapi.RestClient) *PasswordProfile {
 func (o *PasswordProfile) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -56,26 +55,22 @@ func (o *PasswordProfile) Read() error {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 	// Loop through respond results and grab the matched record
 	var results = resp.Result["Results"].([]interface{})
-	//logger.Debugf("Total returned password profile: %d", len(results))
 	// This is the matched list of password profile. There should be only one really
 	var pwdpfs []keyValue
-	//logger.Debugf("Looking for password profile: %s", o.ID)
 	for _, v := range results {
 		item := v.(map[string]interface{})
 		row := item["Row"].(map[string]interface{})
-		//logger.Debugf("Checking name: %s, profiletype: %s", row["Name"], row["ProfileType"])
 		if row["ID"] == o.ID {
-			logger.Debugf("Found an item: %+v", row)
+			o.client.Logger.Debugf("Found an item: %+v", row)
 			// If ProfileType is defined, then compare it
 			if o.ProfileType == "" {
 				pwdpfs = append(pwdpfs, row)
@@ -86,7 +81,7 @@ func (o *PasswordProfile) Read() error {
 	}
 	err = queryError(len(pwdpfs))
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
@@ -106,21 +101,20 @@ func (o *PasswordProfile) Create() (*restapi.StringResponse, error) {
 
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -133,34 +127,33 @@ func (o *PasswordProfile) Create() (*restapi.StringResponse, error) {
 func (o *PasswordProfile) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
 }
 
-// Query function returns a single password profile object
-func (o *PasswordProfile) Query() (map[string]interface{}, error) {
+// QueryAll returns all password profiles in tenant
+func (o *PasswordProfile) QueryAll() ([]map[string]interface{}, error) {
 	var queryArg = make(map[string]interface{})
 	args := make(map[string]interface{})
 	args["Caching"] = -1
@@ -170,24 +163,37 @@ func (o *PasswordProfile) Query() (map[string]interface{}, error) {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
+	}
+
+	var profiles []map[string]interface{}
+	results, _ := resp.Result["Results"].([]interface{})
+	for _, v := range results {
+		item, _ := v.(map[string]interface{})
+		if row, ok := item["Row"].(map[string]interface{}); ok {
+			profiles = append(profiles, row)
+		}
+	}
+	return profiles, nil
+}
+
+// Query function returns a single password profile object
+func (o *PasswordProfile) Query() (map[string]interface{}, error) {
+	profiles, err := o.QueryAll()
+	if err != nil {
+		return nil, err
 	}
-	// Loop through respond results and grab the matched record
-	var results = resp.Result["Results"].([]interface{})
 
 	// This is the matched list of password profile. There should be only one really
 	var pwdpfs []keyValue
-	for _, v := range results {
-		item := v.(map[string]interface{})
-		row := item["Row"].(map[string]interface{})
+	for _, row := range profiles {
 		if row["Name"] == o.Name {
-			logger.Debugf("Found an item: %+v", row)
+			o.client.Logger.Debugf("Found an item: %+v", row)
 			// If ProfileType is defined, then compare it
 			if o.ProfileType == "" {
 				pwdpfs = append(pwdpfs, row)
@@ -199,7 +205,7 @@ func (o *PasswordProfile) Query() (map[string]interface{}, error) {
 
 	err = queryError(len(pwdpfs))
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -214,8 +220,8 @@ func (o *PasswordProfile) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving password profile: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving password profile: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -227,14 +233,14 @@ func (o *PasswordProfile) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of password profile %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of password profile %s. %w", o.Name, err)
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -245,13 +251,13 @@ func (o *PasswordProfile) DeleteByName() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of password profile %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of password profile %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -307,7 +306,7 @@ func NewPolicy(c *restapi.RestClient) *Policy {
 func (o *Policy) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 
@@ -318,13 +317,12 @@ func (o *Policy) Read() error {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	// Fill root level attributes: Path, Description
@@ -332,9 +330,8 @@ func (o *Policy) Read() error {
 
 	// Fill root level attributes: Params, LinkType, PolicySet, Description
 	resp2, err2 := o.Query("")
-	//logger.Debugf("Response for Policy query: %+v", resp2)
 	if err2 != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err2
 	}
 
@@ -412,8 +409,6 @@ func (o *Policy) Read() error {
 	mapToStruct(CloudProvidersSet, settings)
 	o.Settings.CloudProvidersSet = CloudProvidersSet
 
-	//logger.Debugf("Filled object: %+v", o)
-
 	return nil
 }
 
@@ -445,10 +440,9 @@ func (o *Policy) Create() (*restapi.GenericMapResponse, error) {
 	// Convert to nested map
 	nestedmap, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
-	//logger.Debugf("Nested map for Create(): %+v", nestedmap)
 
 	// Flatten Settings
 	var settings = make(map[string]interface{})
@@ -462,17 +456,16 @@ func (o *Policy) Create() (*restapi.GenericMapResponse, error) {
 	policy["Newpolicy"] = true
 	queryArg["policy"] = policy
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -482,7 +475,7 @@ func (o *Policy) Create() (*restapi.GenericMapResponse, error) {
 func (o *Policy) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -490,7 +483,7 @@ func (o *Policy) Update() (*restapi.GenericMapResponse, error) {
 	// Convert to nested map
 	nestedmap, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -517,29 +510,28 @@ func (o *Policy) Update() (*restapi.GenericMapResponse, error) {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg2)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 	policy["RevStamp"] = resp.Result["RevStamp"]
 
 	queryArg["policy"] = policy
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	reply, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !reply.Success {
 		errmsg := fmt.Sprintf("%s %s", reply.Message, reply.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(errmsg)
+		return nil, reply.Err()
 	}
 
 	return reply, nil
@@ -550,13 +542,12 @@ func (o *Policy) Query(key string) (map[string]interface{}, error) {
 	var queryArg = make(map[string]interface{})
 	resp, err := o.client.CallGenericMapAPI(o.apiGetPolicies, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Loop through respond results
@@ -564,7 +555,6 @@ func (o *Policy) Query(key string) (map[string]interface{}, error) {
 	var row map[string]interface{}
 	for _, result := range results {
 		row = result.(map[string]interface{})["Row"].(map[string]interface{})
-		//logger.Debugf("Query row: %+v", row)
 		if strings.EqualFold(key, "name") {
 			if row["PolicySet"] == "/Policy/"+o.Name {
 				return row, nil
@@ -587,8 +577,8 @@ func (o *Policy) GetIDByName() (string, error) {
 
 	result, err := o.Query("name")
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving policy: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving policy: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -600,19 +590,25 @@ func (o *Policy) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of password profile %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of password profile %s. %w", o.Name, err)
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
 }
 
+// QueryAll returns policy links of all policies in tenant
+func (o *Policy) QueryAll() ([]map[string]interface{}, error) {
+	plinks, _, err := o.getPlinks()
+	return plinks, err
+}
+
 func (o *Policy) getPlinks() ([]map[string]interface{}, string, error) {
 	var plinks []map[string]interface{}
 
@@ -620,13 +616,12 @@ func (o *Policy) getPlinks() ([]map[string]interface{}, string, error) {
 	queryArg["Args"] = subArgs
 	resp, err := o.client.CallGenericMapAPI(o.apiGetPolicies, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, "", err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, "", resp.Err()
 	}
 
 	var rev = resp.Result["RevStamp"].(string)
@@ -645,7 +640,7 @@ func (o *Policy) constructPlinks() ([]map[string]interface{}, string) {
 	var plinks []map[string]interface{}
 	oldplinks, rev, err := o.getPlinks()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, ""
 	}
 	// Loop through exiting plinks, find matching plink and remove it
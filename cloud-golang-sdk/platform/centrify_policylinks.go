@@ -3,7 +3,6 @@ package platform
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -45,13 +44,12 @@ func (o *PolicyLinks) GetPlinks() ([]map[string]interface{}, string, error) {
 	queryArg["Args"] = subArgs
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, "", err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, "", resp.Err()
 	}
 
 	var rev = resp.Result["RevStamp"].(string)
@@ -69,7 +67,7 @@ func (o *PolicyLinks) GetPlinks() ([]map[string]interface{}, string, error) {
 func (o *PolicyLinks) Read() error {
 	plinks, _, err := o.GetPlinks()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
@@ -88,7 +86,7 @@ func (o *PolicyLinks) Read() error {
 func (o *PolicyLinks) Update() (*restapi.GenericMapResponse, error) {
 	oldplinks, rev, err := o.GetPlinks()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Only change plinks order, not insert or delete any from the list
@@ -110,16 +108,15 @@ func (o *PolicyLinks) Update() (*restapi.GenericMapResponse, error) {
 	queryArg["Plinks"] = newplinks
 	queryArg["RevStamp"] = rev
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -2,8 +2,9 @@ package platform
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -49,7 +50,7 @@ func NewRole(c *restapi.RestClient) *Role {
 func (o *Role) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -61,13 +62,12 @@ func (o *Role) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -82,7 +82,7 @@ func (o *Role) Read() error {
 	// Get admin rights
 	rights, err := o.GetAdminRights()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	var r []string
@@ -91,8 +91,6 @@ func (o *Role) Read() error {
 	}
 	o.AdminRights = r
 
-	//logger.Debugf("Filled object: %+v", o)
-
 	return nil
 }
 
@@ -106,13 +104,12 @@ func (o *Role) Create() (*restapi.GenericMapResponse, error) {
 
 	resp, err := o.client.CallGenericMapAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 	// Upon successful creation, assign ID
 	o.ID = resp.Result["_RowKey"].(string)
@@ -129,7 +126,7 @@ func (o *Role) Delete() (*restapi.GenericMapResponse, error) {
 func (o *Role) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -143,14 +140,13 @@ func (o *Role) Update() (*restapi.GenericMapResponse, error) {
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -161,7 +157,7 @@ func (o *Role) Update() (*restapi.GenericMapResponse, error) {
 func (o *Role) GetAdminRights() (map[string]interface{}, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -170,7 +166,7 @@ func (o *Role) GetAdminRights() (map[string]interface{}, error) {
 	rights := make(map[string]interface{})
 	resp, err := o.client.CallGenericMapAPI(o.apiGetRights, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -185,9 +181,8 @@ func (o *Role) GetAdminRights() (map[string]interface{}, error) {
 
 		}
 	} else {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return rights, nil
@@ -198,7 +193,7 @@ func (o *Role) GetAdminRights() (map[string]interface{}, error) {
 func (o *Role) AssignAdminRights() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArgs []map[string]interface{}
@@ -207,6 +202,13 @@ func (o *Role) AssignAdminRights() (*restapi.GenericMapResponse, error) {
 	if o.AdminRights != nil && len(o.AdminRights) > 0 {
 		// Fetch full list of admin rights from tenant so that we can know all corresponding path of json files
 		allRights, err := getAllAdminRights(o.client)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateAdminRights(o.AdminRights, allRights); err != nil {
+			o.client.Logger.Errorf(err.Error())
+			return nil, err
+		}
 
 		// Convert o.AdminRights from list to following format:
 		// Role: xxxxx
@@ -220,14 +222,13 @@ func (o *Role) AssignAdminRights() (*restapi.GenericMapResponse, error) {
 
 		resp, err := o.client.CallGenericMapListAPI(o.apiAssignRights, queryArgs)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return nil, err
 		}
 
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return nil, fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return nil, resp.Err()
 		}
 
 		return resp, nil
@@ -241,7 +242,7 @@ func (o *Role) AssignAdminRights() (*restapi.GenericMapResponse, error) {
 func (o *Role) RemoveAdminRights(rights map[string]interface{}) (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArgs []map[string]interface{}
@@ -254,14 +255,13 @@ func (o *Role) RemoveAdminRights(rights map[string]interface{}) (*restapi.Generi
 
 	resp, err := o.client.CallGenericMapListAPI(o.apiUnassignRights, queryArgs)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -277,7 +277,7 @@ func getAllAdminRights(client *restapi.RestClient) (map[string]interface{}, erro
 	rights := make(map[string]interface{})
 	resp, err := client.CallGenericMapAPI("/Redrock/Query", queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -289,22 +289,51 @@ func getAllAdminRights(client *restapi.RestClient) (map[string]interface{}, erro
 			rights[row["Description"].(string)] = row["Path"]
 		}
 	} else {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
-	logger.Debugf("List of all admin rights: %v", rights)
+	client.Logger.Debugf("List of all admin rights: %v", rights)
 
 	return rights, err
 }
 
+// ValidateAdminRights checks that every admin right is available in tenant. Names are case sensitive
+func ValidateAdminRights(client *restapi.RestClient, rights []string) error {
+	allRights, err := getAllAdminRights(client)
+	if err != nil {
+		return err
+	}
+	return validateAdminRights(rights, allRights)
+}
+
+func validateAdminRights(rights []string, allRights map[string]interface{}) error {
+	for _, v := range rights {
+		if _, ok := allRights[v]; ok {
+			continue
+		}
+		for k := range allRights {
+			if strings.EqualFold(k, v) {
+				return fmt.Errorf("Invalid admin right %s, did you mean %s?", v, k)
+			}
+		}
+		var names []string
+		for k := range allRights {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("Invalid admin right %s, valid admin rights are %s", v, strings.Join(names, ","))
+	}
+
+	return nil
+}
+
 // UpdateMembers adds or removes members into or from a role. Actions are 'Add' or 'Delete'. Types are 'Users', 'Roles', 'Groups'
 func (o *Role) UpdateMembers(ids []string, action string, membertype string) (*restapi.StringResponse, error) {
 	var resp *restapi.StringResponse
 	var err error
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	if action != "Add" && action != "Delete" {
@@ -321,13 +350,12 @@ func (o *Role) UpdateMembers(ids []string, action string, membertype string) (*r
 	queryArg[membertype] = actionArg
 	resp, err = o.client.CallStringAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -337,12 +365,13 @@ func (o *Role) UpdateMembers(ids []string, action string, membertype string) (*r
 func (o *Role) UpdateRoleMembers(members []RoleMember, action string) (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	if action != "Add" && action != "Delete" {
 		return nil, fmt.Errorf("Update role action must be either 'Add' or 'Delete'")
 	}
+	o.client.Logger.Debugf("Role members to %s: %+v", action, members)
 	var queryArg = make(map[string]interface{})
 	queryArg["Name"] = o.ID
 	var roleids, groupids, userids []string
@@ -375,13 +404,12 @@ func (o *Role) UpdateRoleMembers(members []RoleMember, action string) (*restapi.
 
 	resp, err := o.client.CallStringAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -390,7 +418,7 @@ func (o *Role) UpdateRoleMembers(members []RoleMember, action string) (*restapi.
 func (o *Role) getMembers() ([]RoleMember, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -400,7 +428,7 @@ func (o *Role) getMembers() ([]RoleMember, error) {
 	var members []RoleMember
 	resp, err := o.client.CallGenericMapAPI(o.apiGetRoleMembers, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -416,9 +444,8 @@ func (o *Role) getMembers() ([]RoleMember, error) {
 			members = append(members, member)
 		}
 	} else {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return members, nil
@@ -426,9 +453,9 @@ func (o *Role) getMembers() ([]RoleMember, error) {
 
 // Query function returns a single role object in map format
 func (o *Role) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Role WHERE 1=1"
+	query := NewQueryBuilder("Role")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -442,8 +469,8 @@ func (o *Role) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving role: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving role: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -455,14 +482,14 @@ func (o *Role) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of role %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of role %s. %w", o.Name, err)
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -473,13 +500,13 @@ func (o *Role) DeleteByName() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of role %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of role %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
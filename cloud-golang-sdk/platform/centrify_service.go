@@ -7,7 +7,6 @@ import (
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/computerclass"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/resourcetype"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -51,7 +50,7 @@ func NewService(c *restapi.RestClient) *Service {
 func (o *Service) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -61,13 +60,12 @@ func (o *Service) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -85,20 +83,19 @@ func (o *Service) Create() (*restapi.StringResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -116,7 +113,7 @@ func (o *Service) Delete() (*restapi.GenericMapResponse, error) {
 func (o *Service) Update() (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -128,20 +125,19 @@ func (o *Service) Update() (*restapi.StringResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 	resp, err := o.client.CallStringAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -149,9 +145,9 @@ func (o *Service) Update() (*restapi.StringResponse, error) {
 
 // Query function returns a single Service object in map format
 func (o *Service) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Subscriptions WHERE 1=1"
+	query := NewQueryBuilder("Subscriptions")
 	if o.Name != "" {
-		query += " AND WindowsServiceName='" + o.Name + "'"
+		query.Equal("WindowsServiceName", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -165,8 +161,8 @@ func (o *Service) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving service: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving service: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -178,8 +174,8 @@ func (o *Service) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of service %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of service %s. %w", o.Name, err)
 		}
 	}
 
@@ -195,8 +191,8 @@ func (o *Service) DeleteByName() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of service %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of service %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -217,7 +213,7 @@ func (o *Service) resolveIDs() error {
 		system.ComputerClass = computerclass.Windows.String()
 		o.SystemID, err = system.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return fmt.Errorf(err.Error())
 		}
 	}
@@ -244,7 +240,7 @@ func (o *Service) resolveIDs() error {
 
 			o.AdminAccountID, err = account.GetIDByName()
 			if err != nil {
-				logger.Errorf(err.Error())
+				o.client.Logger.Errorf(err.Error())
 				return fmt.Errorf(err.Error())
 			}
 		}
@@ -255,7 +251,7 @@ func (o *Service) resolveIDs() error {
 			mplex.Name = o.MultiplexedAccountName
 			o.MultiplexedAccountID, err = mplex.GetIDByName()
 			if err != nil {
-				logger.Errorf(err.Error())
+				o.client.Logger.Errorf(err.Error())
 				return fmt.Errorf(err.Error())
 			}
 		}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -47,7 +46,7 @@ func NewSSHKey(c *restapi.RestClient) *SSHKey {
 func (o *SSHKey) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -55,15 +54,14 @@ func (o *SSHKey) Read() error {
 
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
-	logger.Debugf("Response for SSHKey from tenant: %v", resp)
+	o.client.Logger.Debugf("Response for SSHKey from tenant: %v", resp)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -71,13 +69,12 @@ func (o *SSHKey) Read() error {
 	// Get SSH Key challenge profile information
 	resp, err = o.client.CallGenericMapAPI(o.apiGetChallenge, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 	if v, ok := resp.Result["Challenges"]; ok {
 		challenges := v.(map[string]interface{})
@@ -100,23 +97,22 @@ func (o *SSHKey) Create() (*restapi.StringResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Special handling of challenge checkout profile
 	queryArg["updateChallenges"] = true
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -134,29 +130,28 @@ func (o *SSHKey) Delete() (*restapi.StringResponse, error) {
 func (o *SSHKey) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Special handling of password checkout profile
 	queryArg["updateChallenges"] = true
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -164,9 +159,9 @@ func (o *SSHKey) Update() (*restapi.GenericMapResponse, error) {
 
 // Query function returns a single SSHKey object in map format
 func (o *SSHKey) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM SshKeys WHERE 1=1"
+	query := NewQueryBuilder("SshKeys")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -176,7 +171,7 @@ func (o *SSHKey) Query() (map[string]interface{}, error) {
 func (o *SSHKey) RetriveSSHKey() (string, error) {
 	if o.ID == "" && o.Name == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return "", fmt.Errorf(errormsg)
 	}
 	// If SSHKey name is provided, try to find out its ID
@@ -184,7 +179,7 @@ func (o *SSHKey) RetriveSSHKey() (string, error) {
 		var err error
 		o.ID, err = o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return "", err
 		}
 	}
@@ -203,14 +198,13 @@ func (o *SSHKey) RetriveSSHKey() (string, error) {
 
 	resp, err := o.client.CallStringAPI(o.apiRetrieve, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return "", resp.Err()
 	}
 
 	return resp.Result, nil
@@ -224,8 +218,8 @@ func (o *SSHKey) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving SSHKey: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving SSHKey: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -237,8 +231,8 @@ func (o *SSHKey) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of sshkey %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of sshkey %s. %w", o.Name, err)
 		}
 	}
 
@@ -254,8 +248,8 @@ func (o *SSHKey) DeleteByName() (*restapi.StringResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of sshkey %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of sshkey %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -3,7 +3,6 @@ package platform
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -50,7 +49,7 @@ func NewUser(c *restapi.RestClient) *User {
 func (o *User) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -60,17 +59,15 @@ func (o *User) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
-	//logger.Debugf("Filled object: %+v", o)
 
 	return nil
 }
@@ -92,21 +89,20 @@ func (o *User) Create() (*restapi.StringResponse, error) {
 	}
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 	// Upon successful creation, assign ID
 	o.ID = resp.Result
@@ -118,28 +114,27 @@ func (o *User) Create() (*restapi.StringResponse, error) {
 func (o *User) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -149,7 +144,7 @@ func (o *User) Update() (*restapi.GenericMapResponse, error) {
 func (o *User) ChangePassword() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -159,14 +154,13 @@ func (o *User) ChangePassword() (*restapi.BoolResponse, error) {
 
 	resp, err := o.client.CallBoolAPI(o.apiUpdatePassword, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -181,14 +175,14 @@ func (o *User) ChangeUserPassword(pw string) error {
 		}
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of user %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of user %s. %w", o.Name, err)
 		}
 	}
 	o.Password = pw
 	_, err := o.ChangePassword()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
@@ -197,9 +191,9 @@ func (o *User) ChangeUserPassword(pw string) error {
 
 // Query function returns a single user object in map format
 func (o *User) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM User WHERE 1=1"
+	query := NewQueryBuilder("User")
 	if o.Name != "" {
-		query += " AND Username='" + o.Name + "'"
+		query.Equal("Username", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -213,8 +207,8 @@ func (o *User) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving user '%s': %s", o.Name, err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving user '%s': %w", o.Name, err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -226,7 +220,7 @@ func (o *User) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			return fmt.Errorf("Failed to find ID of user %s. %v", o.Name, err)
+			return fmt.Errorf("Failed to find ID of user %s. %w", o.Name, err)
 		}
 	}
 
@@ -245,7 +239,7 @@ func (o *User) AddToRoles(roles []string) error {
 			role.Name = v
 			id, err := role.GetIDByName()
 			if err != nil {
-				return fmt.Errorf("Failed to find ID of role %s. %v", v, err)
+				return fmt.Errorf("Failed to find ID of role %s. %w", v, err)
 			}
 			role.ID = id
 			resp, err := role.UpdateMembers([]string{o.ID}, "Add", "Users")
@@ -262,7 +256,7 @@ func (o *User) DeleteByName() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			return nil, fmt.Errorf("Failed to find ID of user %s. %v", o.Name, err)
+			return nil, fmt.Errorf("Failed to find ID of user %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
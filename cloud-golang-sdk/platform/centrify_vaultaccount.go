@@ -6,7 +6,6 @@ import (
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/resourcetype"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -22,6 +21,10 @@ type Account struct {
 	apiGetChallenge      string
 	apiCheckoutPassword  string
 	apiCheckinPassword   string
+	apiExtendCheckout    string
+	apiRotatePassword    string
+	apiCheckHealth       string
+	apiUnlockAccount     string
 	apiSetAdminAccount   string
 	apiGetAccessKeys     string
 	apiRetrieveAccessKey string
@@ -78,6 +81,10 @@ func NewAccount(c *restapi.RestClient) *Account {
 	s.apiGetChallenge = "/ServerManage/GetAccountChallenges"
 	s.apiCheckoutPassword = "/ServerManage/CheckoutPassword"
 	s.apiCheckinPassword = "/ServerManage/CheckinPassword"
+	s.apiExtendCheckout = "/ServerManage/ExtendCheckout"
+	s.apiRotatePassword = "/ServerManage/RotatePassword"
+	s.apiCheckHealth = "/ServerManage/CheckAccountHealth"
+	s.apiUnlockAccount = "/ServerManage/UnlockAccount"
 	s.apiPermissions = "/ServerManage/SetAccountPermissions"
 	s.apiSetAdminAccount = "/ServerManage/SetAdministrativeAccounts"
 	s.apiGetAccessKeys = "/Aws/GetAccessKeys"
@@ -93,7 +100,7 @@ func NewAccount(c *restapi.RestClient) *Account {
 func (o *Account) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -102,14 +109,13 @@ func (o *Account) Read() error {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	var va = resp.Result["VaultAccount"].(map[string]interface{})
@@ -120,13 +126,12 @@ func (o *Account) Read() error {
 	// Get password checkout profile information
 	resp, err = o.client.CallGenericMapAPI(o.apiGetChallenge, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 	if v, ok := resp.Result["PasswordCheckoutDefaultProfile"]; ok {
 		o.PasswordCheckoutDefaultProfile = v.(string)
@@ -151,7 +156,7 @@ func (o *Account) Read() error {
 	if o.CloudProviderID != "" {
 		keys, err := o.GetAccessKeys()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 		for _, key := range keys {
@@ -167,20 +172,20 @@ func (o *Account) Create() (*restapi.StringResponse, error) {
 	// Resolve host id if not provided
 	err := o.resolveHostID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	err = o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Special handling of password checkout profile
@@ -190,17 +195,16 @@ func (o *Account) Create() (*restapi.StringResponse, error) {
 		queryArg["WorkflowSent"] = true
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -218,27 +222,27 @@ func (o *Account) Delete() (*restapi.BoolResponse, error) {
 func (o *Account) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	// Resolve host id if not provided
 	err := o.resolveHostID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	err = o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Special handling of password checkout profile
@@ -247,18 +251,17 @@ func (o *Account) Update() (*restapi.GenericMapResponse, error) {
 	// Need to always send this when workflow is turned on and off
 	queryArg["WorkflowSent"] = true
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -268,7 +271,7 @@ func (o *Account) Update() (*restapi.GenericMapResponse, error) {
 func (o *Account) ChangePassword() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -278,19 +281,88 @@ func (o *Account) ChangePassword() (*restapi.BoolResponse, error) {
 
 	resp, err := o.client.CallBoolAPI(o.apiUpdatePassword, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
 }
 
+// RotatePassword makes vault change password of a managed account to a new generated one immediately
+func (o *Account) RotatePassword() error {
+	return o.callAccountAction(o.apiRotatePassword)
+}
+
+// UnlockAccount unlocks account in its domain or system, e.g. after too many failed logins
+func (o *Account) UnlockAccount() error {
+	return o.callAccountAction(o.apiUnlockAccount)
+}
+
+// VerifyCredential checks that credential stored in vault still works on the target.
+// Returns health status reported by vault, and CredentialHealthError if it isn't OK
+func (o *Account) VerifyCredential() (string, error) {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return "", fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["ID"] = o.ID
+
+	resp, err := o.client.CallStringAPI(o.apiCheckHealth, queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return "", err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return "", resp.Err()
+	}
+	if resp.Result != "OK" {
+		return resp.Result, &CredentialHealthError{AccountID: o.ID, Status: resp.Result}
+	}
+
+	return resp.Result, nil
+}
+
+// CredentialHealthError reports credential that vault failed to verify on the target
+type CredentialHealthError struct {
+	AccountID string
+	Status    string // Health status reported by vault, e.g. BadCredentials or Unreachable
+}
+
+func (e *CredentialHealthError) Error() string {
+	return fmt.Sprintf("Credential of account %s failed verification: %s", e.AccountID, e.Status)
+}
+
+// callAccountAction calls an API that takes account ID and returns no result
+func (o *Account) callAccountAction(api string) error {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["ID"] = o.ID
+
+	resp, err := o.client.CallBaseAPI(api, queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
+	}
+
+	return nil
+}
+
 // ValidateCredentialType checks credential type matches password or sshkey setting
 func (o *Account) ValidateCredentialType() error {
 	if o.CredentialType == "Password" && o.Password == "" {
@@ -304,21 +376,21 @@ func (o *Account) ValidateCredentialType() error {
 
 // Query function returns a single Account object in map format
 func (o *Account) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM VaultAccount WHERE 1=1"
+	query := NewQueryBuilder("VaultAccount")
 	if o.User != "" {
-		query += " AND User='" + o.User + "'"
+		query.Equal("User", o.User)
 	}
 	if o.Host != "" {
-		query += " AND Host='" + o.Host + "'"
+		query.Equal("Host", o.Host)
 	}
 	if o.DatabaseID != "" {
-		query += " AND DatabaseID='" + o.DatabaseID + "'"
+		query.Equal("DatabaseID", o.DatabaseID)
 	}
 	if o.DomainID != "" {
-		query += " AND DomainID='" + o.DomainID + "'"
+		query.Equal("DomainID", o.DomainID)
 	}
 	if o.CloudProviderID != "" {
-		query += " AND CloudProviderId='" + o.CloudProviderID + "'"
+		query.Equal("CloudProviderId", o.CloudProviderID)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -328,7 +400,7 @@ func (o *Account) Query() (map[string]interface{}, error) {
 func (o *Account) checkoutPassword() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -337,46 +409,52 @@ func (o *Account) checkoutPassword() (*restapi.GenericMapResponse, error) {
 
 	resp, err := o.client.CallGenericMapAPI(o.apiCheckoutPassword, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
 }
 
-// CheckoutPassword checks out account password from vault
-// Returns actual password, coid or error
-func (o *Account) CheckoutPassword(checkin bool) (string, error) {
+// resolveCheckoutID finds out account ID using User, ResourceType and ResourceName if it is unknown
+func (o *Account) resolveCheckoutID() error {
 	// To checkout account password, we must know its ID
 	// In order to know the ID of the account, we must know username + Host/DatabaseID/DomainID
 	if o.ID == "" {
-		// if ID is unknown, try to find out using User, ResourceType and ResourceName
 		_, err := o.getResourceID()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return "", err
+			o.client.Logger.Errorf(err.Error())
+			return err
 		}
 		acctresult, err := o.Query()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return "", fmt.Errorf("Error retrieving account object: %s", err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Error retrieving account object: %w", err)
 		}
 		o.ID = acctresult["ID"].(string)
 	}
 	// Check again if ID is known
 	if o.ID == "" {
-		return "", fmt.Errorf("Missing ID for account %s in %s with type %s", o.User, o.ResourceName, o.ResourceType)
+		return fmt.Errorf("Missing ID for account %s in %s with type %s", o.User, o.ResourceName, o.ResourceType)
+	}
+	return nil
+}
+
+// CheckoutPassword checks out account password from vault
+// Returns actual password or error. Without checkin, the checkout is left open until it expires, use CheckoutPasswordLease instead
+func (o *Account) CheckoutPassword(checkin bool) (string, error) {
+	if err := o.resolveCheckoutID(); err != nil {
+		return "", err
 	}
 
 	// Checking out password
 	reply, err := o.checkoutPassword()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 
@@ -386,11 +464,11 @@ func (o *Account) CheckoutPassword(checkin bool) (string, error) {
 			if coid != nil {
 				result, err := o.CheckinPassword(coid.(string))
 				if err != nil {
-					logger.Errorf(err.Error())
+					o.client.Logger.Errorf(err.Error())
 					return pw.(string), err
 				}
 				if !result.Success {
-					return pw.(string), fmt.Errorf(result.Message)
+					return pw.(string), result.Err()
 				}
 			} else {
 				return pw.(string), fmt.Errorf("No COID returned from checkout")
@@ -405,7 +483,7 @@ func (o *Account) CheckoutPassword(checkin bool) (string, error) {
 func (o *Account) CheckinPassword(coid string) (*restapi.BoolResponse, error) {
 	if coid == "" {
 		errormsg := fmt.Sprintf("Missing COID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -413,13 +491,35 @@ func (o *Account) CheckinPassword(coid string) (*restapi.BoolResponse, error) {
 
 	resp, err := o.client.CallBoolAPI(o.apiCheckinPassword, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	if !resp.Success {
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
+	}
+
+	return resp, nil
+}
+
+// ExtendCheckout extends a checked out account password by its checkout lifetime
+func (o *Account) ExtendCheckout(coid string) (*restapi.BoolResponse, error) {
+	if coid == "" {
+		errormsg := fmt.Sprintf("Missing COID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return nil, fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["ID"] = coid
+
+	resp, err := o.client.CallBoolAPI(o.apiExtendCheckout, queryArg)
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -431,12 +531,12 @@ func (o *Account) RetrieveSSHKey(keytype string, passphrase string) (string, err
 		// if ID is unknown, try to find out using User, ResourceType and ResourceName
 		_, err := o.getResourceID()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return "", err
 		}
 		acctresult, err := o.Query()
 		if err != nil {
-			return "", fmt.Errorf("Error retrieving account object: %s", err)
+			return "", fmt.Errorf("Error retrieving account object: %w", err)
 		}
 		o.ID = acctresult["ID"].(string)
 		o.CredentialID = acctresult["CredentialId"].(string)
@@ -456,8 +556,8 @@ func (o *Account) RetrieveSSHKey(keytype string, passphrase string) (string, err
 	sshkey.KeyFormat = "PEM"
 	thekey, err := sshkey.RetriveSSHKey()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieve sshkey. %v", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieve sshkey. %w", err)
 	}
 
 	return thekey, nil
@@ -484,8 +584,8 @@ func (o *Account) getResourceID() (string, error) {
 				resource.Name = o.ResourceName
 				result, err := resource.Query()
 				if err != nil {
-					logger.Errorf(err.Error())
-					return "", fmt.Errorf("Error retrieving system object: %s", err)
+					o.client.Logger.Errorf(err.Error())
+					return "", fmt.Errorf("Error retrieving system object: %w", err)
 				}
 				resourceID = result["ID"].(string)
 				o.Host = resourceID
@@ -494,8 +594,8 @@ func (o *Account) getResourceID() (string, error) {
 				resource.Name = o.ResourceName
 				result, err := resource.Query()
 				if err != nil {
-					logger.Errorf(err.Error())
-					return "", fmt.Errorf("Error retrieving database object: %s", err)
+					o.client.Logger.Errorf(err.Error())
+					return "", fmt.Errorf("Error retrieving database object: %w", err)
 				}
 				resourceID = result["ID"].(string)
 				o.DatabaseID = resourceID
@@ -504,8 +604,8 @@ func (o *Account) getResourceID() (string, error) {
 				resource.Name = o.ResourceName
 				result, err := resource.Query()
 				if err != nil {
-					logger.Errorf(err.Error())
-					return "", fmt.Errorf("Error retrieving domain object: %s", err)
+					o.client.Logger.Errorf(err.Error())
+					return "", fmt.Errorf("Error retrieving domain object: %w", err)
 				}
 				resourceID = result["ID"].(string)
 				o.DomainID = resourceID
@@ -514,8 +614,8 @@ func (o *Account) getResourceID() (string, error) {
 				resource.Name = o.ResourceName
 				result, err := resource.Query()
 				if err != nil {
-					logger.Errorf(err.Error())
-					return "", fmt.Errorf("Error retrieving domain object: %s", err)
+					o.client.Logger.Errorf(err.Error())
+					return "", fmt.Errorf("Error retrieving domain object: %w", err)
 				}
 				resourceID = result["ID"].(string)
 				o.CloudProviderID = resourceID
@@ -546,7 +646,7 @@ func (o *Account) ResolveValidPermissions() {
 func (o *Account) SetAdminAccount(enable bool) error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -556,13 +656,12 @@ func (o *Account) SetAdminAccount(enable bool) error {
 	queryArg["Systems"] = []string{o.Host}
 	resp, err := o.client.CallGenericMapAPI(o.apiSetAdminAccount, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	return nil
@@ -572,7 +671,7 @@ func (o *Account) SetAdminAccount(enable bool) error {
 func (o *Account) VerifyAccessKey(key AccessKey) error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -585,13 +684,12 @@ func (o *Account) VerifyAccessKey(key AccessKey) error {
 
 	resp, err := o.client.CallStringAPI(o.apiVerifyAccessKey, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	return nil
@@ -601,7 +699,7 @@ func (o *Account) VerifyAccessKey(key AccessKey) error {
 func (o *Account) AddAccessKey(key AccessKey) error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -612,13 +710,12 @@ func (o *Account) AddAccessKey(key AccessKey) error {
 
 	resp, err := o.client.CallStringAPI(o.apiAddAccessKey, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	return nil
@@ -628,13 +725,13 @@ func (o *Account) AddAccessKey(key AccessKey) error {
 func (o *Account) SafeAddAccessKey(key AccessKey) error {
 	err := o.VerifyAccessKey(key)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	err = o.AddAccessKey(key)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -644,7 +741,7 @@ func (o *Account) SafeAddAccessKey(key AccessKey) error {
 func (o *Account) GetAccessKeys() ([]AccessKey, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -653,21 +750,20 @@ func (o *Account) GetAccessKeys() ([]AccessKey, error) {
 
 	resp, err := o.client.CallSliceAPI(o.apiGetAccessKeys, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	keys := []AccessKey{}
-	logger.Debugf("Get key response: %+v", resp)
+	o.client.Logger.Debugf("Get key response: %+v", resp)
 	for _, p := range resp.Result {
 		key := &AccessKey{}
 		mapToStruct(key, p.(map[string]interface{}))
-		logger.Debugf("Filled key: %+v", key)
+		o.client.Logger.Debugf("Filled key: %+v", key)
 		keys = append(keys, *key)
 	}
 
@@ -678,7 +774,7 @@ func (o *Account) GetAccessKeys() ([]AccessKey, error) {
 func (o *Account) DeleteAccessKey(id string) error {
 	if id == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 
@@ -686,13 +782,12 @@ func (o *Account) DeleteAccessKey(id string) error {
 	queryArg["AccessKeyRowkey"] = id
 	resp, err := o.client.CallStringAPI(o.apiDeleteAccessKey, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 	return nil
 }
@@ -701,7 +796,7 @@ func (o *Account) DeleteAccessKey(id string) error {
 func (o *Account) RetrieveAccessKey(accessKeyID string) (string, error) {
 	if accessKeyID == "" {
 		errormsg := fmt.Sprintf("Missing Access Key ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return "", fmt.Errorf(errormsg)
 	}
 
@@ -711,13 +806,13 @@ func (o *Account) RetrieveAccessKey(accessKeyID string) (string, error) {
 		// if ID is unknown, try to find out using User, ResourceType and ResourceName
 		_, err := o.getResourceID()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return "", err
 		}
 		acctresult, err := o.Query()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return "", fmt.Errorf("Error retrieving account object: %s", err)
+			o.client.Logger.Errorf(err.Error())
+			return "", fmt.Errorf("Error retrieving account object: %w", err)
 		}
 		o.ID = acctresult["ID"].(string)
 	}
@@ -728,7 +823,7 @@ func (o *Account) RetrieveAccessKey(accessKeyID string) (string, error) {
 
 	keys, err := o.GetAccessKeys()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 	var id string
@@ -742,13 +837,12 @@ func (o *Account) RetrieveAccessKey(accessKeyID string) (string, error) {
 	queryArg["AccessKeyRowkey"] = id
 	resp, err := o.client.CallGenericMapAPI(o.apiRetrieveAccessKey, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return "", resp.Err()
 	}
 
 	return resp.Result["SecretAccessKey"].(string), nil
@@ -762,14 +856,14 @@ func (o *Account) GetIDByName() (string, error) {
 
 	_, err := o.getResourceID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving %s %s: %s", GetVarType(o), o.User, err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving %s %s: %w", GetVarType(o), o.User, err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -781,8 +875,8 @@ func (o *Account) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of %s %s. %v", GetVarType(o), o.User, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of %s %s. %w", GetVarType(o), o.User, err)
 		}
 	}
 
@@ -798,8 +892,8 @@ func (o *Account) DeleteByName() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of DesktopApp %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of DesktopApp %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -830,7 +924,6 @@ func (o *Account) processWorkflow() error {
 		// Due to historical reason, WorkflowApprovers attribute is not in json format rather it is in string so need to perform conversion
 		// Convert approvers from struct to string so that it can be assigned to the actual attribute used for privision.
 		o.WorkflowApprovers = FlattenWorkflowApprovers(o.WorkflowApproverList)
-		//logger.Debugf("Converted approvers: %+v", o.WorkflowApprovers)
 
 		if o.WorkflowDefaultOptions == "" {
 			o.WorkflowDefaultOptions = "{\"GrantMin\":60}"
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -62,32 +61,36 @@ func NewDatabase(c *restapi.RestClient) *Database {
 func (o *Database) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg["ID"] = o.ID
-	queryArg["Script"] = "SELECT * FROM VaultDatabase WHERE VaultDatabase.ID = '" + o.ID + "'"
+	script, err := NewQueryBuilder("VaultDatabase").Equal("VaultDatabase.ID", o.ID).Build()
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return err
+	}
+	queryArg["Script"] = script
 	queryArg["Args"] = subArgs
 
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	// Loop through respond results and grab the first record
 	var results = resp.Result["Results"].([]interface{})
 	if len(results) < 1 {
 		// Make sure error message contains "not exist"
-		logger.Debugf("Returning Database does not exist in tenant")
-		return fmt.Errorf("Database does not exist in tenant")
+		o.client.Logger.Debugf("Returning Database does not exist in tenant")
+		return restapi.NewNotFoundError("Database does not exist in tenant")
 	} else if len(results) > 1 {
 		// this should never happen
 		return fmt.Errorf("There are more than one Database with the same ID in tenant")
@@ -95,11 +98,8 @@ func (o *Database) Read() error {
 	var result = results[0].(map[string]interface{})
 	// Populate vaultObject struct with map from response
 	var row = result["Row"].(map[string]interface{})
-	//logger.Debugf("Input map: %+v", row)
 	mapToStruct(o, row)
 
-	//logger.Debugf("Filled object: %+v", o)
-
 	return nil
 }
 
@@ -108,20 +108,19 @@ func (o *Database) Create() (*restapi.StringResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -139,27 +138,26 @@ func (o *Database) Delete() (*restapi.BoolResponse, error) {
 func (o *Database) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -167,21 +165,21 @@ func (o *Database) Update() (*restapi.GenericMapResponse, error) {
 
 // Query function returns a single database object in map format
 func (o *Database) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM VaultDatabase WHERE 1=1"
+	query := NewQueryBuilder("VaultDatabase")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.FQDN != "" {
-		query += " AND FQDN='" + o.FQDN + "'"
+		query.Equal("FQDN", o.FQDN)
 	}
 	if o.DatabaseClass != "" {
-		query += " AND DatabaseClass='" + o.DatabaseClass + "'"
+		query.Equal("DatabaseClass", o.DatabaseClass)
 	}
 	if o.InstanceName != "" {
-		query += " AND InstanceName='" + o.InstanceName + "'"
+		query.Equal("InstanceName", o.InstanceName)
 	}
 	if o.ServiceName != "" {
-		query += " AND ServiceName='" + o.ServiceName + "'"
+		query.Equal("ServiceName", o.ServiceName)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -195,8 +193,8 @@ func (o *Database) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving database: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving database: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -208,8 +206,8 @@ func (o *Database) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of database %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of database %s. %w", o.Name, err)
 		}
 	}
 
@@ -225,8 +223,8 @@ func (o *Database) DeleteByName() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of database %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of database %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -79,31 +78,35 @@ func NewDomain(c *restapi.RestClient) *Domain {
 func (o *Domain) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg["ID"] = o.ID
-	queryArg["Script"] = "SELECT * FROM VaultDomain WHERE ID = '" + o.ID + "'"
+	script, err := NewQueryBuilder("VaultDomain").Equal("ID", o.ID).Build()
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return err
+	}
+	queryArg["Script"] = script
 	queryArg["Args"] = subArgs
 
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	// Loop through respond results and grab the first record
 	var results = resp.Result["Results"].([]interface{})
 	if len(results) < 1 {
 		// Make sure error message contains "not exist"
-		return fmt.Errorf("Domain does not exist in tenant")
+		return restapi.NewNotFoundError("Domain does not exist in tenant")
 	} else if len(results) > 1 {
 		// this should never happen
 		return fmt.Errorf("There are more than one domains with the same ID in tenant")
@@ -111,7 +114,6 @@ func (o *Domain) Read() error {
 	var result = results[0].(map[string]interface{})
 	// Populate vaultObject struct with map from response
 	var row = result["Row"].(map[string]interface{})
-	//logger.Debugf("Input map: %+v", row)
 	mapToStruct(o, row)
 
 	return nil
@@ -123,16 +125,15 @@ func (o *Domain) Create() (*restapi.StringResponse, error) {
 	queryArg["Name"] = o.Name
 	queryArg["VerifyDomain"] = o.VerifyDomain
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -148,19 +149,18 @@ func (o *Domain) Delete() (*restapi.BoolResponse, error) {
 	// Check if the domain can be deleted
 	resp, err := o.client.CallGenericMapAPI(o.apiCanDelete, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 	if resp.Result["can"].(bool) {
 		return o.deleteObjectBoolAPI("")
 	}
 
-	logger.Debugf("Domain cannot be deleted: %+v", resp.Result["why"])
+	o.client.Logger.Debugf("Domain cannot be deleted: %+v", resp.Result["why"])
 	return nil, nil
 }
 
@@ -168,13 +168,13 @@ func (o *Domain) Delete() (*restapi.BoolResponse, error) {
 func (o *Domain) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	err := o.processZoneRoleWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -184,16 +184,15 @@ func (o *Domain) Update() (*restapi.GenericMapResponse, error) {
 		return nil, err
 	}
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -201,9 +200,9 @@ func (o *Domain) Update() (*restapi.GenericMapResponse, error) {
 
 // Query function returns a single Set object in map format
 func (o *Domain) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM VaultDomain WHERE 1=1"
+	query := NewQueryBuilder("VaultDomain")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -233,13 +232,12 @@ func (o *Domain) SetAdminAccount() error {
 
 	resp, err := o.client.CallGenericMapAPI(o.apiSetAdminAccount, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 	return nil
 }
@@ -252,8 +250,8 @@ func (o *Domain) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving domain: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving domain: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -265,14 +263,14 @@ func (o *Domain) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of domain %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of domain %s. %w", o.Name, err)
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -283,13 +281,13 @@ func (o *Domain) DeleteByName() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of domain %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of domain %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -317,7 +315,6 @@ func (o *Domain) processZoneRoleWorkflow() error {
 			}
 			// Convert approvers from struct to string so that it can be assigned to the actual attribute used for privision.
 			o.ZoneRoleWorkflowApprovers = FlattenWorkflowApprovers(o.ZoneRoleWorkflowApproverList)
-			//logger.Debugf("Converted approvers: %+v", o.WorkflowApprovers)
 		}
 	}
 
@@ -6,7 +6,6 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -19,6 +18,8 @@ type Secret struct {
 	apiGetChallenge               string
 	apiRequestSecretDownloadUrl   string
 	apiDownloadSecretFileInChunks string
+	apiRequestSecretUploadUrl     string
+	apiUploadSecretFileInChunks   string
 
 	SecretName              string          `json:"SecretName,omitempty" schema:"secret_name,omitempty"` // User Name
 	SecretText              string          `json:"SecretText,omitempty" schema:"secret_text,omitempty"`
@@ -29,7 +30,8 @@ type Secret struct {
 	ChallengeRules          *ChallengeRules `json:"DataVaultRules,omitempty" schema:"challenge_rule,omitempty"`
 	Sets                    []string        `json:"Sets,omitempty" schema:"sets,omitempty"`
 	NewParentPath           string          `json:"-"`
-	SecretFileName          string          `json:"SecretFileName,omitempty" schema:"secret_filename,omitempty"`
+	SecretFileName          string          `json:"SecretFileName,omitempty"`
+	SecretFilePath          string          `json:"SecretFilePath,omitempty"` // Location of uploaded file content, set by UploadSecretFile
 	WorkflowEnabled         bool            `json:"WorkflowEnabled,omitempty" schema:"workflow_enabled,omitempty"`
 	//WorkflowSent         bool               `json:"WorkflowSent,omitempty" schema:"workflow_sent,omitempty"`
 	WorkflowApprovers      []WorkflowApprover      `json:"WorkflowApprovers,omitempty" schema:"workflow_approver,omitempty"`
@@ -52,6 +54,8 @@ func NewSecret(c *restapi.RestClient) *Secret {
 	s.apiGetChallenge = "/ServerManage/GetSecretRightsAndChallenges"
 	s.apiRequestSecretDownloadUrl = "ServerManage/RequestSecretDownloadUrl"
 	s.apiDownloadSecretFileInChunks = "ServerManage/DownloadSecretFileInChunks"
+	s.apiRequestSecretUploadUrl = "/ServerManage/RequestSecretUploadUrl"
+	s.apiUploadSecretFileInChunks = "/ServerManage/UploadSecretFileInChunks"
 
 	return &s
 }
@@ -60,7 +64,7 @@ func NewSecret(c *restapi.RestClient) *Secret {
 func (o *Secret) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -68,15 +72,14 @@ func (o *Secret) Read() error {
 
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
-	logger.Debugf("Response for Secret from tenant: %v", resp)
+	o.client.Logger.Debugf("Response for Secret from tenant: %v", resp)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -84,13 +87,12 @@ func (o *Secret) Read() error {
 	// Get challenge profile information
 	resp, err = o.client.CallGenericMapAPI(o.apiGetChallenge, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 	if v, ok := resp.Result["DataVaultDefaultProfile"]; ok {
 		o.DataVaultDefaultProfile = v.(string)
@@ -117,20 +119,20 @@ func (o *Secret) Create() (*restapi.StringResponse, error) {
 	// Resolve FolderID if only ParentPath is provided
 	err := o.resolveFolderdID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	err = o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["updateChallenges"] = false
@@ -138,17 +140,16 @@ func (o *Secret) Create() (*restapi.StringResponse, error) {
 		queryArg["WorkflowSent"] = true
 	}
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -166,44 +167,43 @@ func (o *Secret) Delete() (*restapi.BoolResponse, error) {
 func (o *Secret) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	// Resolve FolderID if only ParentPath is provided or NewParentPath is provided for moving into another folder
 	err := o.resolveFolderdID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	err = o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["updateChallenges"] = true
 	// Need to always send this when workflow is turned on and off
 	queryArg["WorkflowSent"] = true
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -213,7 +213,7 @@ func (o *Secret) Update() (*restapi.GenericMapResponse, error) {
 func (o *Secret) MoveSecret() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -221,17 +221,16 @@ func (o *Secret) MoveSecret() (*restapi.BoolResponse, error) {
 	queryArg["targetFolderId"] = o.FolderID
 	//queryArg["updateChallenges"] = true
 
-	logger.Debugf("Generated Map for MoveFolder(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for MoveFolder(): %+v", queryArg)
 
 	resp, err := o.client.CallBoolAPI(o.apiMoveSecret, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -239,12 +238,12 @@ func (o *Secret) MoveSecret() (*restapi.BoolResponse, error) {
 
 // Query function returns a single Secret object in map format
 func (o *Secret) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM DataVault WHERE 1=1"
+	query := NewQueryBuilder("DataVault")
 	if o.SecretName != "" {
-		query += " AND SecretName='" + o.SecretName + "'"
+		query.Equal("SecretName", o.SecretName)
 	}
 	// ParentPath should always be added
-	query += " AND ParentPath='" + o.ParentPath + "'"
+	query.Equal("ParentPath", o.ParentPath)
 
 	return queryVaultObject(o.client, query)
 }
@@ -252,7 +251,7 @@ func (o *Secret) Query() (map[string]interface{}, error) {
 func (o *Secret) checkoutSecret() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -261,13 +260,12 @@ func (o *Secret) checkoutSecret() (*restapi.GenericMapResponse, error) {
 
 	resp, err := o.client.CallGenericMapAPI(o.apiRetrieveSecret, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -280,8 +278,8 @@ func (o *Secret) CheckoutSecret() (string, error) {
 	if o.ID == "" {
 		err := o.GetByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return "", fmt.Errorf("Failed to find secret %s. %v", o.SecretName, err)
+			o.client.Logger.Errorf(err.Error())
+			return "", fmt.Errorf("Failed to find secret %s. %w", o.SecretName, err)
 		}
 	}
 
@@ -292,13 +290,12 @@ func (o *Secret) CheckoutSecret() (string, error) {
 
 	resp, err := o.checkoutSecret()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving secret content for %s: %s", o.SecretName, err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving secret content for %s: %w", o.SecretName, err)
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return "", resp.Err()
 	}
 	if p, ok := resp.Result["SecretText"]; ok {
 		return p.(string), nil
@@ -315,8 +312,8 @@ func (o *Secret) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("Error retrieving secret: %s", err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("Error retrieving secret: %w", err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -328,8 +325,8 @@ func (o *Secret) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("Failed to find ID of secret %s. %v", o.SecretName, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("Failed to find ID of secret %s. %w", o.SecretName, err)
 		}
 	}
 
@@ -345,8 +342,8 @@ func (o *Secret) DeleteByName() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("Failed to find ID of secret %s. %v", o.SecretName, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("Failed to find ID of secret %s. %w", o.SecretName, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -387,8 +384,8 @@ func (o *Secret) DownloadSecretFile(saveToHome bool) (string, error) {
 	if o.ID == "" {
 		err := o.GetByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return "", fmt.Errorf("Failed to find secret %s. %v", o.SecretName, err)
+			o.client.Logger.Errorf(err.Error())
+			return "", fmt.Errorf("Failed to find secret %s. %w", o.SecretName, err)
 		}
 	}
 
@@ -401,13 +398,12 @@ func (o *Secret) DownloadSecretFile(saveToHome bool) (string, error) {
 
 	resp, err := o.client.CallGenericMapAPI(o.apiRequestSecretDownloadUrl, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return "", err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return "", fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return "", resp.Err()
 	}
 
 	secretfilepath := resp.Result["FilePath"].(string)
@@ -433,8 +429,8 @@ func (o *Secret) CheckoutSecretAndFile(saveToHome bool) (string, error) {
 	if o.ID == "" {
 		err := o.GetByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return "", fmt.Errorf("Failed to find secret %s. %v", o.SecretName, err)
+			o.client.Logger.Errorf(err.Error())
+			return "", fmt.Errorf("Failed to find secret %s. %w", o.SecretName, err)
 		}
 	}
 
@@ -447,13 +443,12 @@ func (o *Secret) CheckoutSecretAndFile(saveToHome bool) (string, error) {
 	if o.Type == "Text" {
 		resp, err := o.checkoutSecret()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return "", fmt.Errorf("Error retrieving secret content for %s: %s", o.SecretName, err)
+			o.client.Logger.Errorf(err.Error())
+			return "", fmt.Errorf("Error retrieving secret content for %s: %w", o.SecretName, err)
 		}
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return "", fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return "", resp.Err()
 		}
 		if p, ok := resp.Result["SecretText"]; ok {
 			return p.(string), nil
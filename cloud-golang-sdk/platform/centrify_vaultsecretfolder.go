@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -51,7 +50,7 @@ func NewSecretFolder(c *restapi.RestClient) *SecretFolder {
 func (o *SecretFolder) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -60,21 +59,20 @@ func (o *SecretFolder) Read() error {
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	// Loop through respond results and grab the first record
 	var results = resp.Result["Results"].([]interface{})
 	if len(results) < 1 {
 		// Make sure error message contains "not exist"
-		logger.Debugf("Returning SecretFolder does not exist in tenant")
-		return fmt.Errorf("SecretFolder does not exist in tenant")
+		o.client.Logger.Debugf("Returning SecretFolder does not exist in tenant")
+		return restapi.NewNotFoundError("SecretFolder does not exist in tenant")
 	} else if len(results) > 1 {
 		// this should never happen
 		return fmt.Errorf("There are more than one SecretFolder with the same ID in tenant")
@@ -88,15 +86,13 @@ func (o *SecretFolder) Read() error {
 	// Get challenge profile
 	resp, err = o.client.CallGenericMapAPI(o.apiGetChallenge, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
-	//logger.Debugf("Challenges result: %+v", resp)
 	if v, ok := resp.Result["Challenges"]; ok {
 		challenges := v.(map[string]interface{})
 		if challenges["CollectionMembersDefaultProfile"] != nil {
@@ -118,7 +114,7 @@ func (o *SecretFolder) Create() (*restapi.StringResponse, error) {
 	// Resolve ParentID if only ParentPath is provided
 	err := o.resolveParentID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -129,17 +125,16 @@ func (o *SecretFolder) Create() (*restapi.StringResponse, error) {
 	}
 	queryArg["updateChallenges"] = false
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -157,37 +152,36 @@ func (o *SecretFolder) Delete() (*restapi.BoolResponse, error) {
 func (o *SecretFolder) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	// Resolve ParentID if only ParentPath is provided or NewParentPath is provided for moving into another folder
 	err := o.resolveParentID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["updateChallenges"] = true
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -197,14 +191,14 @@ func (o *SecretFolder) Update() (*restapi.GenericMapResponse, error) {
 func (o *SecretFolder) MoveFolder() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	// Resolve ParentID if only ParentPath is provided or NewParentPath is provided for moving into another folder
 	err := o.resolveParentID()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -213,18 +207,17 @@ func (o *SecretFolder) MoveFolder() (*restapi.BoolResponse, error) {
 	queryArg["targetFolderId"] = o.ParentID
 	//queryArg["updateChallenges"] = true
 
-	logger.Debugf("Generated Map for MoveFolder(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for MoveFolder(): %+v", queryArg)
 
 	resp, err := o.client.CallBoolAPI(o.apiMoveFolder, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -232,22 +225,36 @@ func (o *SecretFolder) MoveFolder() (*restapi.BoolResponse, error) {
 
 // Query function returns a single SecretFolder object in map format
 func (o *SecretFolder) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Sets WHERE 1=1 AND ObjectType='DataVault' AND CollectionType='Phantom'"
+	query := NewQueryBuilder("Sets").Equal("ObjectType", "DataVault").Equal("CollectionType", "Phantom")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.ParentPath != "" {
-		query += " AND ParentPath='" + o.ParentPath + "'"
+		query.Equal("ParentPath", o.ParentPath)
 	}
 
 	return queryVaultObject(o.client, query)
 }
 
+// GetPermissions fetches permissions granted on the folder itself. Member permissions aren't included
+func (o *SecretFolder) GetPermissions() ([]Permission, error) {
+	if o.ID == "" {
+		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
+		o.client.Logger.Errorf(errormsg)
+		return nil, fmt.Errorf(errormsg)
+	}
+	var queryArg = make(map[string]interface{})
+	queryArg["RowKey"] = o.ID
+	queryArg["ReduceSysadmin"] = true
+
+	return getAces(o.client, "/Acl/GetCollectionAces", queryArg)
+}
+
 // SetMemberPermissions sets member permissions. isRemove indicates whether to remove all permissions instead of setting permissions
 func (o *SecretFolder) SetMemberPermissions(isRemove bool) (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -269,16 +276,15 @@ func (o *SecretFolder) SetMemberPermissions(isRemove bool) (*restapi.GenericMapR
 		queryArg["ID"] = o.ID
 		queryArg["PVID"] = o.ID
 		queryArg["Grants"] = permissions
-		logger.Debugf("Generated Map for SetMemberPermissions(): %+v", queryArg)
+		o.client.Logger.Debugf("Generated Map for SetMemberPermissions(): %+v", queryArg)
 		resp, err := o.client.CallGenericMapAPI(o.apiMemberPermissions, queryArg)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return nil, err
 		}
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return nil, fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return nil, resp.Err()
 		}
 		return resp, nil
 	}
@@ -293,9 +299,9 @@ func (o *SecretFolder) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		errormsg := fmt.Sprintf("Failed to retrieve secret folder '%s' in '%s'. %v", o.Name, o.ParentPath, err)
-		logger.Errorf(errormsg)
-		return "", fmt.Errorf(errormsg)
+		err = fmt.Errorf("Failed to retrieve secret folder '%s' in '%s'. %w", o.Name, o.ParentPath, err)
+		o.client.Logger.Errorf(err.Error())
+		return "", err
 	}
 	o.ID = result["ID"].(string)
 
@@ -307,7 +313,7 @@ func (o *SecretFolder) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return fmt.Errorf(err.Error())
 		}
 	}
@@ -324,7 +330,7 @@ func (o *SecretFolder) DeleteByName() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return nil, fmt.Errorf(err.Error())
 		}
 	}
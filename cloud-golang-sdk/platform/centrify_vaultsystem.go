@@ -5,7 +5,6 @@ import (
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/computerclass"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -123,31 +122,35 @@ func NewSystem(c *restapi.RestClient) *System {
 func (o *System) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg["ID"] = o.ID
-	queryArg["Script"] = "SELECT * FROM Server WHERE Server.ID = '" + o.ID + "'"
+	script, err := NewQueryBuilder("Server").Equal("Server.ID", o.ID).Build()
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return err
+	}
+	queryArg["Script"] = script
 	queryArg["Args"] = subArgs
 
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	// Loop through respond results and grab the first record
 	var results = resp.Result["Results"].([]interface{})
 	if len(results) < 1 {
 		// Make sure error message contains "not exist"
-		return fmt.Errorf("System does not exist in tenant")
+		return restapi.NewNotFoundError("System does not exist in tenant")
 	} else if len(results) > 1 {
 		// this should never happen
 		return fmt.Errorf("There are more than one system with the same ID in tenant")
@@ -160,11 +163,11 @@ func (o *System) Read() error {
 	// Get system login profile information
 	resp, err = o.client.CallGenericMapAPI(o.apiGetChallenge, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return resp.Err()
 	}
 	if v, ok := resp.Result["LoginDefaultProfile"]; ok {
 		o.LoginDefaultProfile = v.(string)
@@ -181,11 +184,11 @@ func (o *System) Read() error {
 	// Get Privilege Elevation Challenge profile information
 	resp, err = o.client.CallGenericMapAPI(o.apiGetPrivilegeElevationChallenge, args)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return resp.Err()
 	}
 	if v, ok := resp.Result["PrivilegeElevationDefaultProfile"]; ok {
 		o.PrivilegeElevationDefaultProfile = v.(string)
@@ -200,11 +203,11 @@ func (o *System) Read() error {
 	// Get AgentAuth workflow approvers
 	resp, err = o.client.CallGenericMapAPI(o.apiGetAgentAuthWorkflowConfig, args)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return resp.Err()
 	}
 	// Fill AgentAuthWorkflowApprovers
 	aawfconfig := &AgentAuthWorkflowConfig{}
@@ -215,11 +218,11 @@ func (o *System) Read() error {
 	// Get privilege elevation workflow approvers
 	resp, err = o.client.CallGenericMapAPI(o.apiGetPrivilegeElevationWorkflowConfig, args)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	if !resp.Success {
-		return fmt.Errorf(resp.Message)
+		return resp.Err()
 	}
 	// Fill PrivilegeElevationWorkflowApprovers
 	pewfconfig := &PrivilegeElevationWorkflowConfig{}
@@ -234,35 +237,34 @@ func (o *System) Read() error {
 func (o *System) Create() (*restapi.StringResponse, error) {
 	err := o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	err = o.processZoneRoleWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Special handling of system login profile
 	queryArg["updateChallenges"] = true
 
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 	resp, err := o.client.CallStringAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -280,41 +282,40 @@ func (o *System) Delete() (*restapi.BoolResponse, error) {
 func (o *System) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	err := o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	err = o.processZoneRoleWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	// Special handling of system login profile
 	queryArg["updateChallenges"] = true
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -331,15 +332,15 @@ func (o *System) ValidateZoneWorkflow() error {
 
 // Query function returns a single System object in map format
 func (o *System) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Server WHERE 1=1"
+	query := NewQueryBuilder("Server")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.FQDN != "" {
-		query += " AND FQDN='" + o.FQDN + "'"
+		query.Equal("FQDN", o.FQDN)
 	}
 	if o.ComputerClass != "" {
-		query += " AND ComputerClass='" + o.ComputerClass + "'"
+		query.Equal("ComputerClass", o.ComputerClass)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -360,7 +361,7 @@ func (o *System) GetIDByName() (string, error) {
 	result, err := o.Query()
 	if err != nil {
 		errormsg := fmt.Sprintf("Failed to retrieve system '%s' with type '%s'. %s", o.Name, o.ComputerClass, err)
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return "", fmt.Errorf(errormsg)
 	}
 	o.ID = result["ID"].(string)
@@ -373,14 +374,14 @@ func (o *System) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return fmt.Errorf(err.Error())
 		}
 	}
 
 	err := o.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -391,13 +392,13 @@ func (o *System) DeleteByName() (*restapi.BoolResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return nil, fmt.Errorf(err.Error())
 		}
 	}
 	resp, err := o.Delete()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -452,7 +453,6 @@ func (o *System) processZoneRoleWorkflow() error {
 			}
 			// Convert approvers from struct to string so that it can be assigned to the actual attribute used for privision.
 			o.ZoneRoleWorkflowApprovers = FlattenWorkflowApprovers(o.ZoneRoleWorkflowApproverList)
-			//logger.Debugf("Converted approvers: %+v", o.WorkflowApprovers)
 		}
 	}
 
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/settype"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -48,7 +47,7 @@ func newWebpp(c *restapi.RestClient) *WebApp {
 func (o *WebApp) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -58,14 +57,13 @@ func (o *WebApp) Read() error {
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -77,17 +75,16 @@ func (o *WebApp) Read() error {
 func (o *WebApp) Create() (*restapi.SliceResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg["ID"] = []string{o.TemplateName}
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallSliceAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -101,41 +98,40 @@ func (o *WebApp) Create() (*restapi.SliceResponse, error) {
 func (o *WebApp) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 		err := o.processSpMetaData()
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return nil, err
 		}
 
 	err := o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -145,7 +141,7 @@ func (o *WebApp) Update() (*restapi.GenericMapResponse, error) {
 func (o *WebApp) Delete() (*restapi.SliceResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -153,14 +149,13 @@ func (o *WebApp) Delete() (*restapi.SliceResponse, error) {
 
 	resp, err := o.client.CallSliceAPI(o.apiDelete, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 	return resp, nil
 }
@@ -184,9 +179,9 @@ func (o *WebApp) processWorkflow() error {
 */
 // Query function returns a single WebApp object in map format
 func (o *WebApp) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Application WHERE 1=1 AND AppType='Web'"
+	query := NewQueryBuilder("Application").Equal("AppType", "Web")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -200,8 +195,8 @@ func (o *WebApp) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -214,8 +209,8 @@ func (o *WebApp) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("failed to find ID of %s %s. %v", GetVarType(o), o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("failed to find ID of %s %s. %w", GetVarType(o), o.Name, err)
 		}
 	}
 
@@ -231,8 +226,8 @@ func (o *WebApp) DeleteByName() (*restapi.SliceResponse, error) {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return nil, fmt.Errorf("failed to find ID of WebApp %s. %v", o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return nil, fmt.Errorf("failed to find ID of WebApp %s. %w", o.Name, err)
 		}
 	}
 	resp, err := o.Delete()
@@ -246,7 +241,7 @@ func (o *WebApp) DeleteByName() (*restapi.SliceResponse, error) {
 func (o *WebApp) ResetAppScript() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
@@ -256,7 +251,7 @@ func (o *WebApp) ResetAppScript() error {
 	_, err := o.client.CallGenericMapAPI(o.apiResetAppScript, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 	return nil
@@ -3,7 +3,6 @@ package platform
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -40,25 +39,24 @@ func NewGenericWebApp(c *restapi.RestClient) *GenericWebApp {
 func (o *GenericWebApp) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Read(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Read(): %+v", queryArg)
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -70,17 +68,16 @@ func (o *GenericWebApp) Read() error {
 func (o *GenericWebApp) Create() (*restapi.SliceResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg["ID"] = []string{o.TemplateName}
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallSliceAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -91,7 +88,7 @@ func (o *GenericWebApp) Create() (*restapi.SliceResponse, error) {
 	obj.ID = o.ID
 	obj.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
@@ -102,14 +99,14 @@ func (o *GenericWebApp) Create() (*restapi.SliceResponse, error) {
 func (o *GenericWebApp) CreateComplete() error {
 	_, err := o.Create()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	// Perform update
 	_, err = o.Update()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
@@ -117,7 +114,7 @@ func (o *GenericWebApp) CreateComplete() error {
 	if len(o.Sets) > 0 {
 		err := o.AddToSetsByName(o.Sets)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 	}
@@ -126,12 +123,12 @@ func (o *GenericWebApp) CreateComplete() error {
 	if len(o.Permissions) > 0 {
 		err := ResolvePermissions2(o.client, o.Permissions, o.ValidPermissions)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 		_, err = o.SetPermissions(false)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 	}
@@ -143,35 +140,34 @@ func (o *GenericWebApp) CreateComplete() error {
 func (o *GenericWebApp) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	err := o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -200,8 +196,8 @@ func (o *GenericWebApp) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -213,8 +209,8 @@ func (o *GenericWebApp) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("failed to find ID of %s %s. %v", GetVarType(o), o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("failed to find ID of %s %s. %w", GetVarType(o), o.Name, err)
 		}
 	}
 
@@ -227,9 +223,9 @@ func (o *GenericWebApp) GetByName() error {
 
 // Query function returns a single WebApp object in map format
 func (o *GenericWebApp) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Application WHERE 1=1 AND AppType='Web' AND WebAppType='UsernamePassword'"
+	query := NewQueryBuilder("Application").Equal("AppType", "Web").Equal("WebAppType", "UsernamePassword")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 
 	return queryVaultObject(o.client, query)
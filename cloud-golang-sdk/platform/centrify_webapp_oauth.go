@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/webapp/oauth/applicationtemplate"
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -58,25 +57,24 @@ func NewOauthWebApp(c *restapi.RestClient) *OauthWebApp {
 func (o *OauthWebApp) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Read(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Read(): %+v", queryArg)
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -91,7 +89,7 @@ func (o *OauthWebApp) Read() error {
 func (o *OauthWebApp) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
@@ -100,22 +98,21 @@ func (o *OauthWebApp) Update() (*restapi.GenericMapResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg, err := generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -129,8 +126,8 @@ func (o *OauthWebApp) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -142,8 +139,8 @@ func (o *OauthWebApp) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("failed to find ID of %s %s. %v", GetVarType(o), o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("failed to find ID of %s %s. %w", GetVarType(o), o.Name, err)
 		}
 	}
 
@@ -156,12 +153,12 @@ func (o *OauthWebApp) GetByName() error {
 
 // Query function returns a single WebApp object in map format
 func (o *OauthWebApp) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Application WHERE 1=1 AND AppType='Web' AND WebAppType='OAuth'"
+	query := NewQueryBuilder("Application").Equal("AppType", "Web").Equal("WebAppType", "OAuth")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.ApplicationID != "" {
-		query += " AND ServiceName='" + o.ApplicationID + "'"
+		query.Equal("ServiceName", o.ApplicationID)
 	}
 
 	return queryVaultObject(o.client, query)
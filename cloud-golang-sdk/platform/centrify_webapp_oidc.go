@@ -3,7 +3,6 @@ package platform
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -45,25 +44,24 @@ func NewOidcWebApp(c *restapi.RestClient) *OidcWebApp {
 func (o *OidcWebApp) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Read(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Read(): %+v", queryArg)
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -78,17 +76,16 @@ func (o *OidcWebApp) Read() error {
 func (o *OidcWebApp) Create() (*restapi.SliceResponse, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg["ID"] = []string{o.TemplateName}
-	logger.Debugf("Generated Map for Create(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Create(): %+v", queryArg)
 
 	resp, err := o.client.CallSliceAPI(o.apiCreate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	// Assign ID after successful creation so that the same object can be used for subsequent update operation
@@ -99,7 +96,7 @@ func (o *OidcWebApp) Create() (*restapi.SliceResponse, error) {
 	obj.ID = o.ID
 	obj.Read()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	o.OAuthProfile.ClientID = obj.OAuthProfile.ClientID
@@ -111,14 +108,14 @@ func (o *OidcWebApp) Create() (*restapi.SliceResponse, error) {
 func (o *OidcWebApp) CreateComplete() error {
 	_, err := o.Create()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	// Perform update
 	_, err = o.Update()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
@@ -126,7 +123,7 @@ func (o *OidcWebApp) CreateComplete() error {
 	if len(o.Sets) > 0 {
 		err := o.AddToSetsByName(o.Sets)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 	}
@@ -135,12 +132,12 @@ func (o *OidcWebApp) CreateComplete() error {
 	if len(o.Permissions) > 0 {
 		err := ResolvePermissions2(o.client, o.Permissions, o.ValidPermissions)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 		_, err = o.SetPermissions(false)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 	}
@@ -152,35 +149,34 @@ func (o *OidcWebApp) CreateComplete() error {
 func (o *OidcWebApp) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	err := o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -209,8 +205,8 @@ func (o *OidcWebApp) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -222,8 +218,8 @@ func (o *OidcWebApp) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("failed to find ID of %s %s. %v", GetVarType(o), o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("failed to find ID of %s %s. %w", GetVarType(o), o.Name, err)
 		}
 	}
 
@@ -236,12 +232,12 @@ func (o *OidcWebApp) GetByName() error {
 
 // Query function returns a single WebApp object in map format
 func (o *OidcWebApp) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Application WHERE 1=1 AND AppType='Web' AND WebAppType='OpenIDConnect'"
+	query := NewQueryBuilder("Application").Equal("AppType", "Web").Equal("WebAppType", "OpenIDConnect")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.ApplicationID != "" {
-		query += " AND ServiceName='" + o.ApplicationID + "'"
+		query.Equal("ServiceName", o.ApplicationID)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -3,7 +3,6 @@ package platform
 import (
 	"fmt"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 )
 
@@ -54,25 +53,24 @@ func NewSamlWebApp(c *restapi.RestClient) *SamlWebApp {
 func (o *SamlWebApp) Read() error {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return fmt.Errorf(errormsg)
 	}
 	var queryArg = make(map[string]interface{})
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Read(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Read(): %+v", queryArg)
 	// Attempt to read from an upstream API
 	resp, err := o.client.CallGenericMapAPI(o.apiRead, queryArg)
 
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return err
 	}
 
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return resp.Err()
 	}
 
 	mapToStruct(o, resp.Result)
@@ -84,41 +82,40 @@ func (o *SamlWebApp) Read() error {
 func (o *SamlWebApp) Update() (*restapi.GenericMapResponse, error) {
 	if o.ID == "" {
 		errormsg := fmt.Sprintf("Missing ID for %s", GetVarType(0))
-		logger.Errorf(errormsg)
+		o.client.Logger.Errorf(errormsg)
 		return nil, fmt.Errorf(errormsg)
 	}
 
 	err := o.processSpMetaData()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	err = o.processWorkflow()
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 
 	var queryArg = make(map[string]interface{})
 	queryArg, err = generateRequestMap(o)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	queryArg["_RowKey"] = o.ID
 
-	logger.Debugf("Generated Map for Update(): %+v", queryArg)
+	o.client.Logger.Debugf("Generated Map for Update(): %+v", queryArg)
 
 	resp, err := o.client.CallGenericMapAPI(o.apiUpdate, queryArg)
 	if err != nil {
-		logger.Errorf(err.Error())
+		o.client.Logger.Errorf(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		o.client.Logger.Errorf(resp.Err().Error())
+		return nil, resp.Err()
 	}
 
 	return resp, nil
@@ -147,14 +144,13 @@ func (o *SamlWebApp) processSpMetaData() error {
 		queryArg["Url"] = o.SpMetadataUrl
 		resp, err := o.client.CallGenericMapAPI(o.apiGetSpMetadataFromUrl, queryArg)
 		if err != nil {
-			logger.Errorf(err.Error())
+			o.client.Logger.Errorf(err.Error())
 			return err
 		}
 
 		if !resp.Success {
-			errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-			logger.Errorf(errmsg)
-			return fmt.Errorf(errmsg)
+			o.client.Logger.Errorf(resp.Err().Error())
+			return resp.Err()
 		} else {
 			mapToStruct(o, resp.Result)
 		}
@@ -177,8 +173,8 @@ func (o *SamlWebApp) GetIDByName() (string, error) {
 
 	result, err := o.Query()
 	if err != nil {
-		logger.Errorf(err.Error())
-		return "", fmt.Errorf("error retrieving %s: %s", GetVarType(o), err)
+		o.client.Logger.Errorf(err.Error())
+		return "", fmt.Errorf("error retrieving %s: %w", GetVarType(o), err)
 	}
 	o.ID = result["ID"].(string)
 
@@ -190,8 +186,8 @@ func (o *SamlWebApp) GetByName() error {
 	if o.ID == "" {
 		_, err := o.GetIDByName()
 		if err != nil {
-			logger.Errorf(err.Error())
-			return fmt.Errorf("failed to find ID of %s %s. %v", GetVarType(o), o.Name, err)
+			o.client.Logger.Errorf(err.Error())
+			return fmt.Errorf("failed to find ID of %s %s. %w", GetVarType(o), o.Name, err)
 		}
 	}
 
@@ -204,21 +200,21 @@ func (o *SamlWebApp) GetByName() error {
 
 // Query function returns a single WebApp object in map format
 func (o *SamlWebApp) Query() (map[string]interface{}, error) {
-	query := "SELECT * FROM Application WHERE 1=1 AND AppType='Web' AND WebAppType='Saml'"
+	query := NewQueryBuilder("Application").Equal("AppType", "Web").Equal("WebAppType", "Saml")
 	if o.Name != "" {
-		query += " AND Name='" + o.Name + "'"
+		query.Equal("Name", o.Name)
 	}
 	if o.ServiceName != "" {
-		query += " AND ServiceName='" + o.ServiceName + "'"
+		query.Equal("ServiceName", o.ServiceName)
 	}
 	if o.CorpIdentifier != "" {
-		query += " AND CorpIdentifier='" + o.CorpIdentifier + "'"
+		query.Equal("CorpIdentifier", o.CorpIdentifier)
 	}
 	if o.AdditionalField1 != "" {
-		query += " AND AdditionalField1='" + o.AdditionalField1 + "'"
+		query.Equal("AdditionalField1", o.AdditionalField1)
 	}
 	if o.Audience != "" {
-		query += " AND Audience='" + o.Audience + "'"
+		query.Equal("Audience", o.Audience)
 	}
 
 	return queryVaultObject(o.client, query)
@@ -2,11 +2,11 @@ package platform
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"strings"
 
-	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
 	jsoniter "github.com/json-iterator/go"
 )
@@ -92,7 +92,7 @@ func mapToStruct(i interface{}, m map[string]interface{}) error {
 	jsonString, _ := json.Marshal(m)
 	err := json.Unmarshal(jsonString, i)
 	if err != nil {
-		return fmt.Errorf("Failed to unmarshal map: %v", err)
+		return fmt.Errorf("Failed to unmarshal map: %w", err)
 	}
 
 	return nil
@@ -178,7 +178,8 @@ func flattenSettings(flatten map[string]interface{}, nestedMap interface{}) erro
 	return nil
 }
 
-// RedRockQuery issues RedRock API query
+// RedRockQuery issues RedRock API query and returns results of a single request. Use QueryBuilder.Iterate
+// to page through large result sets
 func RedRockQuery(client *restapi.RestClient, query string, args map[string]interface{}) ([]interface{}, error) {
 	var queryArg = make(map[string]interface{})
 	queryArg["Script"] = query
@@ -188,15 +189,14 @@ func RedRockQuery(client *restapi.RestClient, query string, args map[string]inte
 		queryArg["Args"] = args
 	}
 
-	logger.Debugf("Query arguments: %+v", queryArg)
+	client.Logger.Debugf("Query arguments: %+v", queryArg)
 	resp, err := client.CallGenericMapAPI("/RedRock/query", queryArg)
-	//logger.Debugf("Query response: %+v", resp)
 	if err != nil {
-		logger.ErrorTracef(err.Error())
+		client.Logger.ErrorTracef(err.Error())
 		return nil, err
 	}
 	if !resp.Success {
-		return nil, fmt.Errorf(resp.Message)
+		return nil, resp.Err()
 	}
 
 	var results = resp.Result["Results"].([]interface{})
@@ -204,23 +204,36 @@ func RedRockQuery(client *restapi.RestClient, query string, args map[string]inte
 	return results, nil
 }
 
-func queryVaultObject(client *restapi.RestClient, query string) (map[string]interface{}, error) {
-	results, err := RedRockQuery(client, query, nil)
+// AmbiguousError is returned when lookup of a single object matches more than one object
+type AmbiguousError struct {
+	Count int
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("Query returns too many objects (found %d, expected 1)", e.Count)
+}
+
+// IsAmbiguous reports whether err is caused by lookup that matched more than one object
+func IsAmbiguous(err error) bool {
+	var ambiguous *AmbiguousError
+	return errors.As(err, &ambiguous)
+}
+
+func queryVaultObject(client *restapi.RestClient, query *QueryBuilder) (map[string]interface{}, error) {
+	results, err := query.Run(client, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	if len(results) == 0 {
 		errmsg := "Query returns 0 object"
-		//logger.Errorf(errmsg)
-		logger.ErrorTracef(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		client.Logger.ErrorTracef(errmsg)
+		return nil, restapi.NewNotFoundError(errmsg)
 	}
 	if len(results) > 1 {
-		errmsg := fmt.Sprintf("Query returns too many objects (found %d, expected 1)", len(results))
-		//logger.Errorf(errmsg)
-		logger.ErrorTracef(errmsg)
-		return nil, fmt.Errorf(errmsg)
+		err := &AmbiguousError{Count: len(results)}
+		client.Logger.ErrorTracef(err.Error())
+		return nil, err
 	}
 	var result = results[0].(map[string]interface{})
 	var row = result["Row"].(map[string]interface{})
@@ -301,9 +314,7 @@ func validatePermissions(permissions []Permission, valid []string) error {
 		for _, v := range permissions {
 			rights := strings.Split(v.Rights, ",")
 			if len(intersect(rights, valid)) != len(rights) {
-				errmsg := fmt.Sprintf("%v can only contain %v", rights, valid)
-				logger.ErrorTracef(errmsg)
-				return fmt.Errorf(errmsg)
+				return fmt.Errorf("%v can only contain %v", rights, valid)
 				//return fmt.Errorf("%v can only contain %v", rights, valid)
 			}
 		}
@@ -315,22 +326,22 @@ func validatePermissions(permissions []Permission, valid []string) error {
 // ConvertToValidList converts provide list of rights to actual values that can be used for API call
 // Converts []string{"a1", "b1"} to []string{"a2", "b2"} from map[string]string{"a1": "a2", "b1": "b2"}
 func ConvertToValidList(input []string, validMap map[string]string) ([]string, error) {
-	var converted []string
-	for _, k := range input {
-		v := validMap[k]
-		if v != "" {
-			converted = append(converted, v)
-		} else {
-			errmsg := fmt.Sprintf("Invalid right %s", k)
-			logger.ErrorTracef(errmsg)
-			return nil, fmt.Errorf(errmsg)
-			//return nil, fmt.Errorf("Invalid right %s", k)
-		}
+	converted, err := LookupRights(validMap).ToAPI(input)
+	if err != nil {
+		return nil, err
 	}
 
 	return converted, nil
 }
 
+// ConvertFromValidList is reverse of ConvertToValidList. It converts rights returned by API back to names used in configuration
+// Converts []string{"a2", "b2"} to []string{"a1", "b1"} from map[string]string{"a1": "a2", "b1": "b2"}
+// Rights that aren't in the map are returned unchanged so that they show up as difference
+func ConvertFromValidList(input []string, validMap map[string]string) []string {
+	converted, _ := LookupRights(validMap).FromAPI(input)
+	return converted
+}
+
 // ResolvePermissions given a list of Permissions, resolve PrincipalID and convert the given rights to actual rights
 func ResolvePermissions(c *restapi.RestClient, perms []Permission, validPerms map[string]string) error {
 	var err error
@@ -353,7 +364,7 @@ func ResolvePermissions(c *restapi.RestClient, perms []Permission, validPerms ma
 			}
 		default:
 			errmsg := fmt.Sprintf("Invalid PrincipalType %s", p.PrincipalType)
-			logger.ErrorTracef(errmsg)
+			c.Logger.ErrorTracef(errmsg)
 			return fmt.Errorf(errmsg)
 			//return fmt.Errorf("Invalid PrincipalType %s", p.PrincipalType)
 		}
@@ -370,7 +381,7 @@ func ResolvePermissions(c *restapi.RestClient, perms []Permission, validPerms ma
 		}
 		perms[i].Rights = FlattenSliceToString(rights)
 	}
-	logger.Debugf("Resolved permissions: %+v", perms)
+	c.Logger.Debugf("Resolved permissions: %+v", perms)
 
 	return nil
 }
@@ -398,7 +409,7 @@ func ResolvePermissions2(c *restapi.RestClient, perms []Permission, validPerms m
 				}
 			default:
 				errmsg := fmt.Sprintf("Invalid PrincipalType %s", p.PrincipalType)
-				logger.ErrorTracef(errmsg)
+				c.Logger.ErrorTracef(errmsg)
 				return fmt.Errorf(errmsg)
 				//return fmt.Errorf("Invalid PrincipalType %s", p.PrincipalType)
 			}
@@ -416,26 +427,22 @@ func ResolvePermissions2(c *restapi.RestClient, perms []Permission, validPerms m
 		}
 		perms[i].Rights = FlattenSliceToString(rights)
 	}
-	logger.Debugf("Resolved permissions: %+v", perms)
+	c.Logger.Debugf("Resolved permissions: %+v", perms)
 
 	return nil
 }
 
 func noFoundError() string {
-	errmsg := "Query returns 0 object"
-	logger.Errorf(errmsg)
-	return errmsg
+	return "Query returns 0 object"
 }
 
 func foundTooManyError(no int) string {
-	errmsg := fmt.Sprintf("Query returns too many objects (found %d, expected 1)", no)
-	logger.Errorf(errmsg)
-	return errmsg
+	return fmt.Sprintf("Query returns too many objects (found %d, expected 1)", no)
 }
 
 func queryError(no int) error {
 	if no == 0 {
-		return fmt.Errorf(noFoundError())
+		return restapi.NewNotFoundError(noFoundError())
 	}
 	if no > 1 {
 		return fmt.Errorf(foundTooManyError(no))
@@ -466,9 +473,7 @@ func FlattenWorkflowApprovers(approvers []WorkflowApprover) string {
 		for i := 0; i < num; i++ {
 			field := fields.Field(i)
 			value := values.Field(i)
-			//logger.Debugf("Field name %s value is %v", field.Name, value)
 			if field.Name != "DirectoryService" && field.Name != "DirectoryName" {
-				//logger.Debugf("Field name %s is being flatenning", field.Name)
 				switch field.Name {
 				case "OptionsSelector":
 					if value.Bool() {
@@ -541,14 +546,12 @@ func ResolveWorkflowApprovers(c *restapi.RestClient, approvers []WorkflowApprove
 			if err != nil {
 				return err
 			}
-			//logger.Debugf("Resolved Directory Service: %+v", dir)
 			// Get directory object
 			objs := NewDirectoryObjects(c)
 			obj, err := objs.GetByName(v.Type, v.Name, *dir)
 			if err != nil {
 				return err
 			}
-			//logger.Debugf("Resolved Directory Object: %+v", obj)
 			if v.Type == "Role" {
 				v.Guid = obj.RoleID
 			} else {
@@ -579,40 +582,42 @@ func ResolveWorkflowApprovers(c *restapi.RestClient, approvers []WorkflowApprove
 	return nil
 }
 
+// GetAllZoneRoles returns zone roles of domain keyed by role name. Roles are fetched a page at a time
 func GetAllZoneRoles(c *restapi.RestClient, domainid string) (map[string]ZoneRole, error) {
-	var queryArgs = make(map[string]interface{})
-	queryArgs["PageNumber"] = 1
-	queryArgs["PageSize"] = 100000
-	queryArgs["Limit"] = 100000
-	queryArgs["SortBy"] = ""
-	queryArgs["direction"] = false
-	queryArgs["Caching"] = -1
-
-	var requestArg = make(map[string]interface{})
-	requestArg["DomainId"] = domainid
-	requestArg["Args"] = subArgs
-
-	// Attempt to read from an upstream API
-	resp, err := c.CallSliceAPI("/ZoneRoleWorkflow/GetAllRoles", requestArg)
-	if err != nil {
-		logger.Errorf(err.Error())
-		return nil, err
-	}
-	if resp.Success {
-		var zonerolemap = make(map[string]ZoneRole)
-		results := resp.Result
-		for _, v := range results {
+	var zonerolemap = make(map[string]ZoneRole)
+	for pageNumber := 1; ; pageNumber++ {
+		var queryArgs = make(map[string]interface{})
+		queryArgs["PageNumber"] = pageNumber
+		queryArgs["PageSize"] = DefaultPageSize
+		queryArgs["SortBy"] = ""
+		queryArgs["direction"] = false
+		queryArgs["Caching"] = -1
+
+		var requestArg = make(map[string]interface{})
+		requestArg["DomainId"] = domainid
+		requestArg["Args"] = queryArgs
+
+		// Attempt to read from an upstream API
+		resp, err := c.CallSliceAPI("/ZoneRoleWorkflow/GetAllRoles", requestArg)
+		if err != nil {
+			c.Logger.Errorf(err.Error())
+			return nil, err
+		}
+		if !resp.Success {
+			c.Logger.Errorf(resp.Err().Error())
+			return nil, resp.Err()
+		}
+
+		found := len(zonerolemap)
+		for _, v := range resp.Result {
 			zonerole := &ZoneRole{}
 			mapToStruct(zonerole, v.(map[string]interface{}))
-			if zonerole != nil {
-				zonerolemap[zonerole.Name] = *zonerole
-			}
+			zonerolemap[zonerole.Name] = *zonerole
+		}
+		// Stop at the last page. A page that adds nothing means paging isn't honored and all roles were returned
+		if len(resp.Result) < DefaultPageSize || len(zonerolemap) == found {
+			return zonerolemap, nil
 		}
-		return zonerolemap, nil
-	} else {
-		errmsg := fmt.Sprintf("%s %s", resp.Message, resp.Exception)
-		logger.Errorf(errmsg)
-		return nil, fmt.Errorf(errmsg)
 	}
 }
 
@@ -628,7 +633,6 @@ func FlattenZoneRoles(zoneroles []ZoneRole) string {
 		for i := 0; i < num; i++ {
 			field := fields.Field(i)
 			value := values.Field(i)
-			//logger.Debugf("Field name %s value is %v", field.Name, value)
 			switch field.Name {
 			case "Windows", "Unix":
 				if value.Bool() {
@@ -668,7 +672,7 @@ func FlattenZoneRoles(zoneroles []ZoneRole) string {
 func resolveZoneRoles(c *restapi.RestClient, zoneroles []ZoneRole, domainid string) error {
 	if domainid == "" {
 		errmsg := "missing domain id"
-		logger.ErrorTracef(errmsg)
+		c.Logger.ErrorTracef(errmsg)
 		return fmt.Errorf(errmsg)
 	}
 	// Retrieve all zone roles
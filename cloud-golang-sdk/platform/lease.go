@@ -0,0 +1,202 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// DefaultLeaseLifetime is checkout lifetime used when account doesn't have its own, which is the tenant default
+const DefaultLeaseLifetime = 60 * time.Minute
+
+// leaseCheckinTimeout limits how long check-in may take once the lease context is done
+const leaseCheckinTimeout = 30 * time.Second
+
+// leaseExtendTimeout limits how long a single checkout extension may take
+const leaseExtendTimeout = 30 * time.Second
+
+// PasswordLease - Encapsulates a checked out account password that must be checked in by Close
+type PasswordLease struct {
+	account  *Account
+	COID     string
+	Password string
+	Lifetime time.Duration // Checkout lifetime, from DefaultCheckoutTime of the account
+
+	mu     sync.Mutex
+	expiry time.Time
+	closed bool
+	err    error
+	done   chan struct{}
+}
+
+// openLeases keeps leases that aren't closed yet so that CloseLeases can check them in on exit
+var openLeases = struct {
+	sync.Mutex
+	leases map[*PasswordLease]bool
+}{leases: make(map[*PasswordLease]bool)}
+
+// CheckoutPasswordLease checks out account password and returns a lease. The password is checked in when Close or
+// CloseLeases is called or ctx is done. If autoExtend is true, checkout is extended before it expires until then
+func (o *Account) CheckoutPasswordLease(ctx context.Context, autoExtend bool) (*PasswordLease, error) {
+	if err := o.resolveCheckoutID(); err != nil {
+		return nil, err
+	}
+
+	reply, err := o.checkoutPassword()
+	if err != nil {
+		o.client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	pw, ok := reply.Result["Password"].(string)
+	if !ok {
+		return nil, fmt.Errorf("Password checkout call doesn't contain password")
+	}
+	lease := &PasswordLease{
+		account:  o,
+		Password: pw,
+		Lifetime: time.Duration(o.DefaultCheckoutTime) * time.Minute,
+		done:     make(chan struct{}),
+	}
+	lease.COID, _ = reply.Result["COID"].(string)
+	if lease.COID == "" {
+		return nil, fmt.Errorf("No COID returned from checkout of account %s", o.ID)
+	}
+	if lease.Lifetime <= 0 {
+		lease.Lifetime = DefaultLeaseLifetime
+	}
+	lease.expiry = time.Now().Add(lease.Lifetime)
+
+	openLeases.Lock()
+	openLeases.leases[lease] = true
+	openLeases.Unlock()
+
+	go lease.renew(ctx, autoExtend)
+
+	return lease, nil
+}
+
+// Expiry returns the time checkout expires unless it is extended
+func (l *PasswordLease) Expiry() time.Time {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.expiry
+}
+
+// Extend extends checkout by its lifetime
+func (l *PasswordLease) Extend() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return fmt.Errorf("Checkout %s is already checked in", l.COID)
+	}
+	// Client of the account may be bound to a call that has already completed, so extension gets its own context
+	ctx, cancel := context.WithTimeout(context.Background(), leaseExtendTimeout)
+	defer cancel()
+	account := NewAccount(l.account.client.WithContext(ctx))
+	if _, err := account.ExtendCheckout(l.COID); err != nil {
+		return err
+	}
+	l.expiry = time.Now().Add(l.Lifetime)
+	return nil
+}
+
+// Close checks in the password. It is safe to call Close more than once, later calls return result of the first one
+func (l *PasswordLease) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseCheckinTimeout)
+	defer cancel()
+	return l.close(ctx)
+}
+
+// close checks in the password with ctx rather than context of the account client, which may be the one that is done
+func (l *PasswordLease) close(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return l.err
+	}
+	l.closed = true
+	close(l.done)
+
+	openLeases.Lock()
+	delete(openLeases.leases, l)
+	openLeases.Unlock()
+
+	account := NewAccount(l.account.client.WithContext(ctx))
+	if _, err := account.CheckinPassword(l.COID); err != nil {
+		l.err = fmt.Errorf("Failed to check in %s: %w", l.COID, err)
+	}
+	return l.err
+}
+
+// renew checks in the password when ctx is done and extends checkout before it expires if autoExtend is true
+func (l *PasswordLease) renew(ctx context.Context, autoExtend bool) {
+	for {
+		var timer *time.Timer
+		var extend <-chan time.Time
+		if autoExtend {
+			// Extend when a fifth of lifetime is left so that a slow call doesn't let checkout expire,
+			// but not more often than every tenth of lifetime after a failed attempt
+			wait := time.Until(l.Expiry()) - l.Lifetime/5
+			if wait < l.Lifetime/10 {
+				wait = l.Lifetime / 10
+			}
+			timer = time.NewTimer(wait)
+			extend = timer.C
+		}
+		select {
+		case <-l.done:
+		case <-ctx.Done():
+			if err := l.Close(); err != nil {
+				l.account.client.Logger.Errorf(err.Error())
+			}
+		case <-extend:
+			if err := l.Extend(); err != nil {
+				l.account.client.Logger.Errorf("Failed to extend checkout %s: %v", l.COID, err)
+				// Nothing left to extend once checkout has expired
+				autoExtend = time.Now().Before(l.Expiry())
+			}
+			continue
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+		return
+	}
+}
+
+// CloseLeases checks in all passwords whose leases aren't closed yet. It is meant to be called before process exits
+func CloseLeases() error {
+	ctx, cancel := context.WithTimeout(context.Background(), leaseCheckinTimeout)
+	defer cancel()
+	return CloseLeasesWithContext(ctx)
+}
+
+// CloseLeasesWithContext is like CloseLeases but check-ins are abandoned when ctx is done.
+// Passwords are checked in concurrently so that one slow call doesn't use up the time of others
+func CloseLeasesWithContext(ctx context.Context) error {
+	openLeases.Lock()
+	var leases []*PasswordLease
+	for l := range openLeases.leases {
+		leases = append(leases, l)
+	}
+	openLeases.Unlock()
+
+	errs := make([]error, len(leases))
+	var wg sync.WaitGroup
+	for i, l := range leases {
+		wg.Add(1)
+		go func(i int, l *PasswordLease) {
+			defer wg.Done()
+			errs[i] = l.close(ctx)
+		}(i, l)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+)
+
+// checkoutServer hands out a new COID for every checkout and records check-ins and extensions
+type checkoutServer struct {
+	mu           sync.Mutex
+	count        int
+	checkins     map[string]int
+	extends      map[string]int
+	checkinDelay time.Duration
+}
+
+func (s *checkoutServer) start(t *testing.T) *httptest.Server {
+	s.checkins = make(map[string]int)
+	s.extends = make(map[string]int)
+	return restapitest.NewServer(t, map[string]restapitest.Handler{
+		"/ServerManage/CheckoutPassword": func(r *restapitest.Request) interface{} {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.count++
+			return map[string]interface{}{"Password": "secret", "COID": fmt.Sprintf("coid-%d", s.count)}
+		},
+		"/ServerManage/CheckinPassword": func(r *restapitest.Request) interface{} {
+			time.Sleep(s.checkinDelay)
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.checkins[r.Args["ID"].(string)]++
+			return true
+		},
+		"/ServerManage/ExtendCheckout": func(r *restapitest.Request) interface{} {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			s.extends[r.Args["ID"].(string)]++
+			return true
+		},
+	})
+}
+
+func (s *checkoutServer) checkedIn(coid string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.checkins[coid]
+}
+
+func TestPasswordLease(t *testing.T) {
+	tenant := &checkoutServer{}
+	server := tenant.start(t)
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	account := NewAccount(client)
+	account.ID = "account-1"
+	account.DefaultCheckoutTime = 30
+	lease, err := account.CheckoutPasswordLease(context.Background(), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Password != "secret" || lease.COID != "coid-1" || lease.Lifetime != 30*time.Minute {
+		t.Errorf("unexpected lease %+v", lease)
+	}
+	if until := time.Until(lease.Expiry()); until < 29*time.Minute || until > 30*time.Minute {
+		t.Errorf("expected lease to expire in 30 minutes, got %v", until)
+	}
+
+	expiry := lease.Expiry()
+	time.Sleep(time.Millisecond)
+	if err := lease.Extend(); err != nil {
+		t.Fatal(err)
+	}
+	if !lease.Expiry().After(expiry) || tenant.extends["coid-1"] != 1 {
+		t.Errorf("expected checkout to be extended")
+	}
+
+	if err := lease.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := lease.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if tenant.checkedIn("coid-1") != 1 {
+		t.Errorf("expected exactly one check-in, got %d", tenant.checkedIn("coid-1"))
+	}
+	if err := lease.Extend(); err == nil {
+		t.Errorf("expected closed lease not to be extended")
+	}
+}
+
+func TestPasswordLeaseCheckin(t *testing.T) {
+	tenant := &checkoutServer{}
+	server := tenant.start(t)
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	// Lease is checked in when its context is done, even if client uses the same context
+	ctx, cancel := context.WithCancel(context.Background())
+	account := NewAccount(client.WithContext(ctx))
+	account.ID = "account-1"
+	lease, err := account.CheckoutPasswordLease(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Lifetime != DefaultLeaseLifetime {
+		t.Errorf("expected default lifetime, got %v", lease.Lifetime)
+	}
+	cancel()
+	for i := 0; i < 100 && tenant.checkedIn("coid-1") == 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if tenant.checkedIn("coid-1") != 1 {
+		t.Errorf("expected lease to be checked in after context is cancelled")
+	}
+
+	// Remaining leases are checked in by CloseLeases
+	account = NewAccount(client)
+	account.ID = "account-1"
+	if _, err := account.CheckoutPasswordLease(context.Background(), false); err != nil {
+		t.Fatal(err)
+	}
+	if err := CloseLeases(); err != nil {
+		t.Fatal(err)
+	}
+	if tenant.checkedIn("coid-2") != 1 {
+		t.Errorf("expected CloseLeases to check in coid-2")
+	}
+}
+
+func TestCloseLeasesConcurrently(t *testing.T) {
+	tenant := &checkoutServer{checkinDelay: 300 * time.Millisecond}
+	server := tenant.start(t)
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	for i := 0; i < 3; i++ {
+		account := NewAccount(client)
+		account.ID = "account-1"
+		if _, err := account.CheckoutPasswordLease(context.Background(), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Slow check-ins run side by side, so all of them fit in a deadline shorter than their sum
+	ctx, cancel := context.WithTimeout(context.Background(), 800*time.Millisecond)
+	defer cancel()
+	if err := CloseLeasesWithContext(ctx); err != nil {
+		t.Fatal(err)
+	}
+	for _, coid := range []string{"coid-1", "coid-2", "coid-3"} {
+		if tenant.checkedIn(coid) != 1 {
+			t.Errorf("expected %s to be checked in", coid)
+		}
+	}
+}
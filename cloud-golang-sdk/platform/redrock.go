@@ -0,0 +1,434 @@
+package platform
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+// Table and column names may be qualified by table name, e.g. Server.ID
+var identifierPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*)?$`)
+
+// QueryBuilder builds RedRock SQL script. Values are always emitted as escaped literals and
+// table and column names are validated so that user supplied values can't alter the query
+type QueryBuilder struct {
+	table      string
+	columns    []string
+	predicates []string
+	orderBy    []string
+	limit      int
+	err        error
+}
+
+// NewQueryBuilder returns QueryBuilder that selects from table
+func NewQueryBuilder(table string) *QueryBuilder {
+	q := &QueryBuilder{}
+	q.table = q.identifier(table)
+	return q
+}
+
+// Select sets columns to return. All columns are returned if it isn't called
+func (q *QueryBuilder) Select(columns ...string) *QueryBuilder {
+	for _, c := range columns {
+		q.columns = append(q.columns, q.identifier(c))
+	}
+	return q
+}
+
+// Equal adds column = value predicate. nil value matches NULL
+func (q *QueryBuilder) Equal(column string, value interface{}) *QueryBuilder {
+	if value == nil {
+		return q.IsNull(column)
+	}
+	return q.compare(column, "=", value)
+}
+
+// NotEqual adds column != value predicate
+func (q *QueryBuilder) NotEqual(column string, value interface{}) *QueryBuilder {
+	return q.compare(column, "!=", value)
+}
+
+// GreaterThan adds column > value predicate
+func (q *QueryBuilder) GreaterThan(column string, value interface{}) *QueryBuilder {
+	return q.compare(column, ">", value)
+}
+
+// LessThan adds column < value predicate
+func (q *QueryBuilder) LessThan(column string, value interface{}) *QueryBuilder {
+	return q.compare(column, "<", value)
+}
+
+// Like adds column LIKE pattern predicate. % and _ in pattern are wildcards, use EscapeLike to match them literally
+func (q *QueryBuilder) Like(column string, pattern string) *QueryBuilder {
+	col := q.identifier(column)
+	q.predicates = append(q.predicates, fmt.Sprintf("%s LIKE %s ESCAPE '\\'", col, q.literal(pattern)))
+	return q
+}
+
+// In adds column IN (values) predicate. Query matches nothing if values is empty
+func (q *QueryBuilder) In(column string, values ...interface{}) *QueryBuilder {
+	col := q.identifier(column)
+	if len(values) == 0 {
+		q.predicates = append(q.predicates, "1=0")
+		return q
+	}
+	literals := make([]string, len(values))
+	for i, v := range values {
+		literals[i] = q.literal(v)
+	}
+	q.predicates = append(q.predicates, fmt.Sprintf("%s IN (%s)", col, strings.Join(literals, ", ")))
+	return q
+}
+
+// IsNull adds column IS NULL predicate
+func (q *QueryBuilder) IsNull(column string) *QueryBuilder {
+	q.predicates = append(q.predicates, q.identifier(column)+" IS NULL")
+	return q
+}
+
+// OrderBy sorts results by column
+func (q *QueryBuilder) OrderBy(column string, ascending bool) *QueryBuilder {
+	order := " ASC"
+	if !ascending {
+		order = " DESC"
+	}
+	q.orderBy = append(q.orderBy, q.identifier(column)+order)
+	return q
+}
+
+// Limit sets maximum number of rows returned. 0 means no limit
+func (q *QueryBuilder) Limit(n int) *QueryBuilder {
+	if n < 0 {
+		q.setErr(fmt.Errorf("Invalid query limit %d", n))
+	}
+	q.limit = n
+	return q
+}
+
+// Build returns SQL script. Error is returned if any table or column name or value is invalid
+func (q *QueryBuilder) Build() (string, error) {
+	if q.err != nil {
+		return "", q.err
+	}
+
+	columns := "*"
+	if len(q.columns) > 0 {
+		columns = strings.Join(q.columns, ", ")
+	}
+	script := fmt.Sprintf("SELECT %s FROM %s", columns, q.table)
+	if len(q.predicates) > 0 {
+		script += " WHERE " + strings.Join(q.predicates, " AND ")
+	}
+	if len(q.orderBy) > 0 {
+		script += " ORDER BY " + strings.Join(q.orderBy, ", ")
+	}
+	if q.limit > 0 {
+		script += " LIMIT " + strconv.Itoa(q.limit)
+	}
+
+	return script, nil
+}
+
+// Run issues the query and returns result rows
+func (q *QueryBuilder) Run(client *restapi.RestClient, args map[string]interface{}) ([]interface{}, error) {
+	script, err := q.Build()
+	if err != nil {
+		client.Logger.Errorf(err.Error())
+		return nil, err
+	}
+	return RedRockQuery(client, script, args)
+}
+
+// PrepareScript validates hand written RedRock script and binds named arguments to it. Script must be a
+// single SELECT statement without comments. Each @name outside of quotes is replaced by escaped literal of
+// args["name"] and error is returned if it isn't in args
+func PrepareScript(script string, args map[string]interface{}) (string, error) {
+	fields := strings.Fields(script)
+	if len(fields) == 0 || !strings.EqualFold(fields[0], "SELECT") {
+		return "", fmt.Errorf("Query script must be a SELECT statement")
+	}
+
+	var out strings.Builder
+	runes := []rune(script)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '\'' || r == '"' || r == '[':
+			// Copy quoted literal or identifier as is
+			closing := r
+			if r == '[' {
+				closing = ']'
+			}
+			j := i + 1
+			for ; j < len(runes); j++ {
+				if runes[j] == closing {
+					// Doubled quote is an escaped quote
+					if closing != ']' && j+1 < len(runes) && runes[j+1] == closing {
+						j++
+						continue
+					}
+					break
+				}
+			}
+			if j == len(runes) {
+				return "", fmt.Errorf("Query script has unterminated %c", r)
+			}
+			out.WriteString(string(runes[i : j+1]))
+			i = j
+		case r == ';':
+			if strings.TrimSpace(string(runes[i+1:])) != "" {
+				return "", fmt.Errorf("Query script must be a single statement")
+			}
+			return out.String(), nil
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-', r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			return "", fmt.Errorf("Query script must not contain comments")
+		case r == '@':
+			j := i + 1
+			for ; j < len(runes) && (runes[j] == '_' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])); j++ {
+			}
+			name := string(runes[i+1 : j])
+			if name == "" {
+				return "", fmt.Errorf("Query script has @ without argument name")
+			}
+			value, ok := args[name]
+			if !ok {
+				return "", fmt.Errorf("Query argument %s isn't provided", name)
+			}
+			lit, err := literal(value)
+			if err != nil {
+				return "", err
+			}
+			out.WriteString(lit)
+			i = j - 1
+		default:
+			out.WriteRune(r)
+		}
+	}
+
+	return out.String(), nil
+}
+
+// EscapeLike escapes LIKE wildcards in s so that it is matched literally by Like
+func EscapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// QuoteLiteral returns s as SQL string literal
+func QuoteLiteral(s string) string {
+	return "'" + strings.Replace(s, "'", "''", -1) + "'"
+}
+
+func (q *QueryBuilder) compare(column string, op string, value interface{}) *QueryBuilder {
+	col := q.identifier(column)
+	q.predicates = append(q.predicates, col+op+q.literal(value))
+	return q
+}
+
+func (q *QueryBuilder) identifier(name string) string {
+	if !identifierPattern.MatchString(name) {
+		q.setErr(fmt.Errorf("Invalid table or column name %q in query", name))
+	}
+	return name
+}
+
+func (q *QueryBuilder) literal(value interface{}) string {
+	lit, err := literal(value)
+	if err != nil {
+		q.setErr(err)
+	}
+	return lit
+}
+
+// literal returns value as SQL literal
+func literal(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		if strings.ContainsRune(v, 0) {
+			return "", fmt.Errorf("Query value %q contains NUL character", v)
+		}
+		return QuoteLiteral(v), nil
+	case bool:
+		if v {
+			return "1", nil
+		}
+		return "0", nil
+	case int:
+		return strconv.Itoa(v), nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64), nil
+	case time.Time:
+		return QuoteLiteral(v.UTC().Format(time.RFC3339)), nil
+	default:
+		return "", fmt.Errorf("Unsupported query value type %T", value)
+	}
+}
+
+func (q *QueryBuilder) setErr(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// DefaultPageSize is number of rows RowIterator fetches per RedRock request
+const DefaultPageSize = 1000
+
+// RowIterator pages through RedRock query results using PageNumber and PageSize so that large
+// result sets are neither loaded in one request nor truncated. Typical usage:
+//
+//	it := NewQueryBuilder("VaultAccount").Iterate(client, 0)
+//	for it.Next() {
+//		row := it.Row()
+//		...
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type RowIterator struct {
+	client   *restapi.RestClient
+	script   string
+	pageSize int
+
+	pageNumber int
+	page       []interface{}
+	index      int
+	fetched    int
+	total      int
+	counted    bool // whether total is FullCount reported by RedRock
+	done       bool
+	row        map[string]interface{}
+	err        error
+}
+
+// Iterate returns RowIterator over rows of the query. DefaultPageSize is used if pageSize is 0
+func (q *QueryBuilder) Iterate(client *restapi.RestClient, pageSize int) *RowIterator {
+	script, err := q.Build()
+	it := NewRowIterator(client, script, pageSize)
+	it.err = err
+	return it
+}
+
+// NewRowIterator returns RowIterator over rows returned by RedRock script. DefaultPageSize is used if pageSize is 0.
+// Use PrepareScript to validate script that isn't built by QueryBuilder
+func NewRowIterator(client *restapi.RestClient, script string, pageSize int) *RowIterator {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	return &RowIterator{
+		client:   client,
+		script:   script,
+		pageSize: pageSize,
+		total:    -1,
+	}
+}
+
+// Next advances to the next row, fetching next page when needed. It returns false when there are
+// no more rows or an error occurred
+func (it *RowIterator) Next() bool {
+	it.row = nil
+	for it.index >= len(it.page) {
+		if it.done || it.err != nil {
+			return false
+		}
+		it.fetch()
+	}
+	item, ok := it.page[it.index].(map[string]interface{})
+	it.index++
+	if !ok {
+		it.err = fmt.Errorf("Unexpected RedRock result %+v", it.page[it.index-1])
+		return false
+	}
+	it.row, _ = item["Row"].(map[string]interface{})
+	return true
+}
+
+// Row returns current row
+func (it *RowIterator) Row() map[string]interface{} {
+	return it.row
+}
+
+// Scan populates struct pointed by v from current row. Row attributes are matched by json tags of v
+func (it *RowIterator) Scan(v interface{}) error {
+	if it.row == nil {
+		return fmt.Errorf("Scan called without current row")
+	}
+	return mapToStruct(v, it.row)
+}
+
+// Total returns number of rows matched by the query as reported by RedRock. First page is fetched if needed.
+// If RedRock doesn't report the count, it is number of rows fetched so far
+func (it *RowIterator) Total() (int, error) {
+	if it.total < 0 && it.err == nil && !it.done {
+		it.fetch()
+	}
+	if it.err != nil {
+		return 0, it.err
+	}
+	return it.total, nil
+}
+
+// Err returns error that stopped iteration, if any
+func (it *RowIterator) Err() error {
+	return it.err
+}
+
+// ForEach calls fn for every row until rows are exhausted or fn returns error
+func (it *RowIterator) ForEach(fn func(row map[string]interface{}) error) error {
+	for it.Next() {
+		if err := fn(it.Row()); err != nil {
+			return err
+		}
+	}
+	return it.Err()
+}
+
+func (it *RowIterator) fetch() {
+	it.pageNumber++
+	var args = make(map[string]interface{})
+	args["PageNumber"] = it.pageNumber
+	args["PageSize"] = it.pageSize
+	args["Caching"] = -1
+
+	var queryArg = make(map[string]interface{})
+	queryArg["Script"] = it.script
+	queryArg["Args"] = args
+
+	it.client.Logger.Debugf("Query arguments: %+v", queryArg)
+	resp, err := it.client.CallGenericMapAPI("/RedRock/query", queryArg)
+	if err != nil {
+		it.client.Logger.ErrorTracef(err.Error())
+		it.err = err
+		return
+	}
+	if !resp.Success {
+		it.err = resp.Err()
+		return
+	}
+
+	it.page, _ = resp.Result["Results"].([]interface{})
+	it.index = 0
+	it.fetched += len(it.page)
+	if fullCount, ok := resp.Result["FullCount"].(float64); ok {
+		it.total = int(fullCount)
+		it.counted = true
+	}
+
+	switch {
+	case it.counted && it.fetched >= it.total:
+		it.done = true
+	case len(it.page) < it.pageSize:
+		it.done = true
+		if it.counted {
+			it.err = fmt.Errorf("RedRock query returned %d of %d rows", it.fetched, it.total)
+		}
+	}
+	if !it.counted {
+		it.total = it.fetched
+	}
+}
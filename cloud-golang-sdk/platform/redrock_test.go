@@ -0,0 +1,280 @@
+package platform
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+)
+
+var hostileNames = []struct {
+	name    string
+	literal string
+}{
+	{"O'Brien", `'O''Brien'`},
+	{"x' OR '1'='1", `'x'' OR ''1''=''1'`},
+	{"'; DELETE FROM Role; --", `'''; DELETE FROM Role; --'`},
+	{`back\slash'`, `'back\slash'''`},
+	{"multi\nline", "'multi\nline'"},
+	{"ünïcödé ' 名前", `'ünïcödé '' 名前'`},
+}
+
+func TestQueryBuilderEscapesValues(t *testing.T) {
+	for _, tc := range hostileNames {
+		script, err := NewQueryBuilder("Role").Equal("Name", tc.name).Build()
+		if err != nil {
+			t.Fatalf("%q: %v", tc.name, err)
+		}
+		expected := "SELECT * FROM Role WHERE Name=" + tc.literal
+		if script != expected {
+			t.Errorf("%q: expected %s, got %s", tc.name, expected, script)
+		}
+	}
+}
+
+func TestQueryBuilderBuild(t *testing.T) {
+	cases := []struct {
+		name     string
+		query    *QueryBuilder
+		expected string
+	}{
+		{"all", NewQueryBuilder("Server"), "SELECT * FROM Server"},
+		{
+			"columns, order and limit",
+			NewQueryBuilder("User").Select("ID", "Username").OrderBy("Username", true).OrderBy("ID", false).Limit(10),
+			"SELECT ID, Username FROM User ORDER BY Username ASC, ID DESC LIMIT 10",
+		},
+		{
+			"typed predicates",
+			NewQueryBuilder("Proxy").Equal("Online", true).NotEqual("Version", "1.0").GreaterThan("Port", 22).Equal("Forest", nil),
+			"SELECT * FROM Proxy WHERE Online=1 AND Version!='1.0' AND Port>22 AND Forest IS NULL",
+		},
+		{
+			"qualified column",
+			NewQueryBuilder("Server").Equal("Server.ID", "abc"),
+			"SELECT * FROM Server WHERE Server.ID='abc'",
+		},
+		{
+			"in",
+			NewQueryBuilder("Role").In("Name", "a", "b'c"),
+			"SELECT * FROM Role WHERE Name IN ('a', 'b''c')",
+		},
+		{"empty in", NewQueryBuilder("Role").In("Name"), "SELECT * FROM Role WHERE 1=0"},
+		{
+			"like",
+			NewQueryBuilder("DataVault").Like("SecretName", EscapeLike("100%_o'k")+"%"),
+			`SELECT * FROM DataVault WHERE SecretName LIKE '100\%\_o''k%' ESCAPE '\'`,
+		},
+	}
+	for _, tc := range cases {
+		script, err := tc.query.Build()
+		if err != nil {
+			t.Errorf("%s: %v", tc.name, err)
+		} else if script != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.name, tc.expected, script)
+		}
+	}
+}
+
+func TestQueryBuilderRejectsInvalidInput(t *testing.T) {
+	cases := map[string]*QueryBuilder{
+		"table":      NewQueryBuilder("Role; DROP TABLE Role"),
+		"column":     NewQueryBuilder("Role").Equal("Name='x' OR 1", "y"),
+		"select":     NewQueryBuilder("Role").Select("*, Password"),
+		"order":      NewQueryBuilder("Role").OrderBy("Name DESC; --", true),
+		"nul":        NewQueryBuilder("Role").Equal("Name", "a\x00b"),
+		"value type": NewQueryBuilder("Role").Equal("Name", []string{"a"}),
+		"limit":      NewQueryBuilder("Role").Limit(-1),
+	}
+	for name, q := range cases {
+		if script, err := q.Build(); err == nil {
+			t.Errorf("%s: expected error, got %s", name, script)
+		}
+	}
+}
+
+// TestQuerySendsEscapedScript checks that object lookups by name send escaped script to RedRock
+func TestQuerySendsEscapedScript(t *testing.T) {
+	var script string
+	query := func(r *restapitest.Request) interface{} {
+		script, _ = r.Args["Script"].(string)
+		return map[string]interface{}{"Results": []interface{}{map[string]interface{}{"Row": map[string]interface{}{"ID": "1"}}}}
+	}
+	server := restapitest.NewServer(t, map[string]restapitest.Handler{
+		"/RedRock/query": query,
+		"/Redrock/Query": query,
+	})
+	defer server.Close()
+	client, err := restapi.GetNewRestClient(server.URL, server.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range hostileNames {
+		secret := NewSecret(client)
+		secret.SecretName = tc.name
+		secret.ParentPath = tc.name
+		if _, err := secret.Query(); err != nil {
+			t.Fatalf("%q: %v", tc.name, err)
+		}
+		expected := "SELECT * FROM DataVault WHERE SecretName=" + tc.literal + " AND ParentPath=" + tc.literal
+		if script != expected {
+			t.Errorf("secret %q: expected %s, got %s", tc.name, expected, script)
+		}
+
+		connector := NewConnector(client)
+		connector.Name = tc.name
+		if _, err := connector.Query(); err != nil {
+			t.Fatalf("%q: %v", tc.name, err)
+		}
+		expected = "SELECT * FROM Proxy WHERE Name=" + tc.literal
+		if script != expected {
+			t.Errorf("connector %q: expected %s, got %s", tc.name, expected, script)
+		}
+	}
+}
+
+// redRockPager serves rows pages of RedRock results honoring PageNumber and PageSize. FullCount is
+// reported as fullCount if it is set
+func redRockPager(t *testing.T, rows int, fullCount int, requests *int) *httptest.Server {
+	return restapitest.NewServer(t, map[string]restapitest.Handler{
+		"/RedRock/query": func(r *restapitest.Request) interface{} {
+			*requests++
+			var body struct {
+				Args struct{ PageNumber, PageSize int }
+			}
+			if err := r.Decode(&body); err != nil {
+				t.Errorf("decoding request: %v", err)
+			}
+			var results []interface{}
+			for i := (body.Args.PageNumber - 1) * body.Args.PageSize; i < rows && i < body.Args.PageNumber*body.Args.PageSize; i++ {
+				results = append(results, map[string]interface{}{"Row": map[string]interface{}{"ID": fmt.Sprintf("id%d", i), "Name": fmt.Sprintf("name%d", i)}})
+			}
+			result := map[string]interface{}{"Results": results, "Count": len(results)}
+			if fullCount > 0 {
+				result["FullCount"] = fullCount
+			}
+			return result
+		},
+	})
+}
+
+func TestRowIteratorPages(t *testing.T) {
+	cases := []struct {
+		name      string
+		rows      int
+		fullCount int
+		requests  int
+	}{
+		{"empty", 0, 0, 1},
+		{"partial page", 5, 5, 1},
+		{"exact pages", 20, 20, 2},
+		{"several pages", 25, 25, 3},
+		{"without count", 25, 0, 3},
+		{"exact pages without count", 20, 0, 3},
+	}
+	for _, tc := range cases {
+		var requests int
+		server := redRockPager(t, tc.rows, tc.fullCount, &requests)
+		client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+		it := NewQueryBuilder("VaultAccount").Iterate(client, 10)
+		var rows int
+		for it.Next() {
+			account := struct{ ID, Name string }{}
+			if err := it.Scan(&account); err != nil {
+				t.Fatal(err)
+			}
+			if account.ID != fmt.Sprintf("id%d", rows) {
+				t.Errorf("%s: expected row %d, got %+v", tc.name, rows, account)
+			}
+			rows++
+		}
+		if it.Err() != nil {
+			t.Errorf("%s: %v", tc.name, it.Err())
+		}
+		total, _ := it.Total()
+		if rows != tc.rows || total != tc.rows || requests != tc.requests {
+			t.Errorf("%s: expected %d rows in %d requests, got %d rows (total %d) in %d requests", tc.name, tc.rows, tc.requests, rows, total, requests)
+		}
+		server.Close()
+	}
+}
+
+func TestRowIteratorReportsTruncation(t *testing.T) {
+	var requests int
+	server := redRockPager(t, 15, 30, &requests)
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	it := NewQueryBuilder("VaultAccount").Iterate(client, 10)
+	if total, err := it.Total(); err != nil || total != 30 {
+		t.Errorf("expected total 30, got %d %v", total, err)
+	}
+	var rows int
+	err := it.ForEach(func(row map[string]interface{}) error {
+		rows++
+		return nil
+	})
+	if err == nil || rows != 15 {
+		t.Errorf("expected truncation error after 15 rows, got %d rows and %v", rows, err)
+	}
+}
+
+func TestRowIteratorForEachStops(t *testing.T) {
+	var requests int
+	server := redRockPager(t, 25, 25, &requests)
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	stop := fmt.Errorf("stop")
+	var rows int
+	err := NewQueryBuilder("VaultAccount").Iterate(client, 10).ForEach(func(row map[string]interface{}) error {
+		rows++
+		if row["Name"] == "name3" {
+			return stop
+		}
+		return nil
+	})
+	if err != stop || rows != 4 || requests != 1 {
+		t.Errorf("expected to stop at 4th row after 1 request, got %d rows, %d requests, %v", rows, requests, err)
+	}
+}
+
+func TestPrepareScript(t *testing.T) {
+	args := map[string]interface{}{"name": "x' OR '1'='1", "set": "Unix Servers", "port": 22}
+	cases := []struct {
+		script   string
+		expected string
+	}{
+		{"SELECT * FROM Server WHERE Name=@name", `SELECT * FROM Server WHERE Name='x'' OR ''1''=''1'`},
+		{"select ID from Server where Port=@port and Name='@name';  ", "select ID from Server where Port=22 and Name='@name'"},
+		{"SELECT [a;b], \"c--d\" FROM Sets WHERE Name=@set AND Description='it''s -- fine'", `SELECT [a;b], "c--d" FROM Sets WHERE Name='Unix Servers' AND Description='it''s -- fine'`},
+	}
+	for _, tc := range cases {
+		script, err := PrepareScript(tc.script, args)
+		if err != nil {
+			t.Errorf("%s: %v", tc.script, err)
+		} else if script != tc.expected {
+			t.Errorf("%s: expected %s, got %s", tc.script, tc.expected, script)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"DELETE FROM Server",
+		"SELECT * FROM Server; DELETE FROM Server",
+		"SELECT * FROM Server -- comment",
+		"SELECT * FROM Server /* comment */",
+		"SELECT * FROM Server WHERE Name='unterminated",
+		"SELECT * FROM Server WHERE Name=@missing",
+		"SELECT * FROM Server WHERE Name=@",
+	}
+	for _, script := range invalid {
+		if prepared, err := PrepareScript(script, args); err == nil {
+			t.Errorf("%q: expected error, got %s", script, prepared)
+		}
+	}
+}
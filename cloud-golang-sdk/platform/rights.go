@@ -0,0 +1,126 @@
+package platform
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// RightsMap converts rights between names used in configuration and names used by API for one object type,
+// e.g. Checkout and Naked for account. Lookups are case insensitive
+type RightsMap struct {
+	Type    string
+	toAPI   map[string]string // Lower case configuration name to API name
+	fromAPI map[string]string // Lower case API name to configuration name
+	valid   []string          // Configuration names in sorted order
+}
+
+// InvalidRightsError is returned when rights aren't valid for object type
+type InvalidRightsError struct {
+	Type   string
+	Rights []string
+	Valid  []string
+}
+
+func (e *InvalidRightsError) Error() string {
+	return fmt.Sprintf("Invalid right %s for %s, valid rights are %s", strings.Join(e.Rights, ","), e.Type, strings.Join(e.Valid, ","))
+}
+
+// NewRightsMap is a RightsMap constructor. valid maps configuration name to API name as ValidPermissionMap does
+func NewRightsMap(objectType string, valid map[string]string) *RightsMap {
+	r := &RightsMap{
+		Type:    objectType,
+		toAPI:   make(map[string]string),
+		fromAPI: make(map[string]string),
+	}
+	for name, apiName := range valid {
+		r.toAPI[strings.ToLower(name)] = apiName
+		r.fromAPI[strings.ToLower(apiName)] = name
+		r.valid = append(r.valid, name)
+	}
+	sort.Strings(r.valid)
+
+	return r
+}
+
+// Valid returns configuration names of valid rights in sorted order
+func (r *RightsMap) Valid() []string {
+	return append([]string(nil), r.valid...)
+}
+
+// ToAPI converts configuration names to API names. Result is sorted and has no duplicates. Unknown rights are
+// returned unchanged along with InvalidRightsError
+func (r *RightsMap) ToAPI(rights []string) ([]string, error) {
+	return r.convert(rights, r.toAPI)
+}
+
+// FromAPI converts API names to configuration names. Result is sorted and has no duplicates. Unknown rights are
+// returned unchanged along with InvalidRightsError
+func (r *RightsMap) FromAPI(rights []string) ([]string, error) {
+	return r.convert(rights, r.fromAPI)
+}
+
+func (r *RightsMap) convert(rights []string, m map[string]string) ([]string, error) {
+	var converted, invalid []string
+	seen := make(map[string]bool)
+	for _, right := range rights {
+		right = strings.TrimSpace(right)
+		if right == "" {
+			continue
+		}
+		v, ok := m[strings.ToLower(right)]
+		if !ok {
+			v = right
+			invalid = append(invalid, right)
+		}
+		if !seen[v] {
+			seen[v] = true
+			converted = append(converted, v)
+		}
+	}
+	sort.Strings(converted)
+
+	if len(invalid) > 0 {
+		return converted, &InvalidRightsError{Type: r.Type, Rights: invalid, Valid: r.Valid()}
+	}
+	return converted, nil
+}
+
+// rightsRegistry holds RightsMap of each map in ValidPermissionMap keyed by map identity
+var rightsRegistry = make(map[uintptr]*RightsMap)
+
+// RightsTypes lists object types in ValidPermissionMap, e.g. Account or WinNix
+var RightsTypes []string
+
+func init() {
+	v := reflect.ValueOf(ValidPermissionMap)
+	for i := 0; i < v.NumField(); i++ {
+		name := v.Type().Field(i).Name
+		rightsRegistry[v.Field(i).Pointer()] = NewRightsMap(name, v.Field(i).Interface().(map[string]string))
+		RightsTypes = append(RightsTypes, name)
+	}
+}
+
+// GetRightsMap returns RightsMap of object type in ValidPermissionMap, e.g. Account
+func GetRightsMap(objectType string) (*RightsMap, error) {
+	v := reflect.ValueOf(ValidPermissionMap).FieldByName(objectType)
+	if !v.IsValid() {
+		return nil, fmt.Errorf("Invalid object type %s for rights, valid types are %s", objectType, strings.Join(RightsTypes, ","))
+	}
+	return rightsRegistry[v.Pointer()], nil
+}
+
+// LookupRights returns RightsMap of valid permission map such as ValidPermissions of an object. Maps that aren't
+// in ValidPermissionMap are wrapped as they are
+func LookupRights(valid map[string]string) *RightsMap {
+	if r, ok := rightsRegistry[reflect.ValueOf(valid).Pointer()]; ok && valid != nil {
+		return r
+	}
+	return NewRightsMap("object", valid)
+}
+
+// Rights returns RightsMap of object's valid permissions
+func (o *vaultObject) Rights() *RightsMap {
+	return LookupRights(o.ValidPermissions)
+}
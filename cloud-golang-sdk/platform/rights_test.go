@@ -0,0 +1,109 @@
+package platform
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestRightsRoundTrip converts every right of every map to API name and back
+func TestRightsRoundTrip(t *testing.T) {
+	v := reflect.ValueOf(ValidPermissionMap)
+	if len(RightsTypes) != v.NumField() {
+		t.Fatalf("expected %d registered types, got %v", v.NumField(), RightsTypes)
+	}
+	for i, objectType := range RightsTypes {
+		valid := v.Field(i).Interface().(map[string]string)
+		rights, err := GetRightsMap(objectType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if LookupRights(valid) != rights {
+			t.Errorf("%s: LookupRights returned different map", objectType)
+		}
+		if len(rights.Valid()) != len(valid) {
+			t.Errorf("%s: expected %d valid rights, got %v", objectType, len(valid), rights.Valid())
+		}
+
+		for name, apiName := range valid {
+			for _, input := range []string{name, strings.ToLower(name), strings.ToUpper(name), " " + name + " "} {
+				out, err := rights.ToAPI([]string{input})
+				if err != nil || !reflect.DeepEqual(out, []string{apiName}) {
+					t.Errorf("%s: ToAPI(%q) expected [%s], got %v %v", objectType, input, apiName, out, err)
+				}
+			}
+			for _, input := range []string{apiName, strings.ToLower(apiName)} {
+				out, err := rights.FromAPI([]string{input})
+				if err != nil || !reflect.DeepEqual(out, []string{name}) {
+					t.Errorf("%s: FromAPI(%q) expected [%s], got %v %v", objectType, input, name, out, err)
+				}
+			}
+		}
+
+		all, err := rights.ToAPI(rights.Valid())
+		if err != nil {
+			t.Fatalf("%s: %v", objectType, err)
+		}
+		back, err := rights.FromAPI(all)
+		if err != nil || !reflect.DeepEqual(back, rights.Valid()) {
+			t.Errorf("%s: expected %v after round trip, got %v %v", objectType, rights.Valid(), back, err)
+		}
+	}
+}
+
+func TestRightsNormalize(t *testing.T) {
+	rights, _ := GetRightsMap("Account")
+	cases := []struct {
+		name    string
+		input   []string
+		toAPI   []string
+		fromAPI []string
+		invalid []string
+		inverse bool
+	}{
+		{"order", []string{"View", "Checkout", "Grant"}, []string{"Naked", "Owner", "View"}, nil, nil, false},
+		{"case and duplicates", []string{"view", "VIEW", "Edit", "edit", ""}, []string{"Manage", "View"}, nil, nil, false},
+		{"unknown", []string{"View", "Run", "Execute"}, []string{"Execute", "Run", "View"}, nil, []string{"Run", "Execute"}, false},
+		{"from api", []string{"UserPortalLogin", "owner", "Naked", "Naked"}, nil, []string{"Checkout", "Grant", "WorkspaceLogin"}, nil, true},
+		{"unknown from api", []string{"Owner", "Checkout"}, nil, []string{"Checkout", "Grant"}, []string{"Checkout"}, true},
+	}
+	for _, tc := range cases {
+		var out []string
+		var err error
+		expected := tc.toAPI
+		if tc.inverse {
+			out, err = rights.FromAPI(tc.input)
+			expected = tc.fromAPI
+		} else {
+			out, err = rights.ToAPI(tc.input)
+		}
+		if !reflect.DeepEqual(out, expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, expected, out)
+		}
+		var invalid *InvalidRightsError
+		if tc.invalid == nil && err != nil {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+		} else if tc.invalid != nil && (!errors.As(err, &invalid) || !reflect.DeepEqual(invalid.Rights, tc.invalid)) {
+			t.Errorf("%s: expected invalid rights %v, got %v", tc.name, tc.invalid, err)
+		}
+	}
+}
+
+func TestRightsErrors(t *testing.T) {
+	rights, _ := GetRightsMap("SSHKey")
+	_, err := rights.ToAPI([]string{"Checkout"})
+	expected := "Invalid right Checkout for SSHKey, valid rights are Delete,Edit,Grant,Retrieve,View"
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected %q, got %v", expected, err)
+	}
+	if _, err := ConvertToValidList([]string{"Checkout"}, ValidPermissionMap.SSHKey); err == nil || err.Error() != expected {
+		t.Errorf("ConvertToValidList: expected %q, got %v", expected, err)
+	}
+	if _, err := GetRightsMap("Printer"); err == nil || !strings.Contains(err.Error(), "Account") {
+		t.Errorf("expected error listing valid types, got %v", err)
+	}
+	if r := LookupRights(map[string]string{"Grant": "Owner"}); r.Type != "object" {
+		t.Errorf("expected unregistered map to be wrapped, got %s", r.Type)
+	}
+}
@@ -0,0 +1,123 @@
+package platform
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/secrettype"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+const (
+	// MaxSecretFileSize is the largest file content that can be stored in a file secret
+	MaxSecretFileSize = 5 * 1024 * 1024
+	// DefaultSecretFileChunkSize is the size of chunks file content is uploaded in
+	DefaultSecretFileChunkSize = 512 * 1024
+)
+
+// SecretFileUpload is file content being uploaded for a file secret. Uploaded chunks are tracked
+// so that a failed upload is resumed from the first missing chunk when UploadSecretFile is called again
+type SecretFileUpload struct {
+	FileName  string
+	Content   []byte
+	Hash      string // Hex encoded SHA-256 hash of Content
+	ChunkSize int
+	FilePath  string // Location of the upload in tenant
+	Uploaded  int    // Number of chunks uploaded so far
+}
+
+// NewSecretFileUpload prepares content of file fileName for upload. Returns error if content is empty or too large
+func NewSecretFileUpload(fileName string, content []byte) (*SecretFileUpload, error) {
+	if fileName == "" {
+		return nil, fmt.Errorf("Missing name of secret file")
+	}
+	if len(content) == 0 {
+		return nil, fmt.Errorf("Content of secret file %s is empty", fileName)
+	}
+	if len(content) > MaxSecretFileSize {
+		return nil, fmt.Errorf("Secret file %s is %d bytes, maximum size is %d bytes", fileName, len(content), MaxSecretFileSize)
+	}
+
+	return &SecretFileUpload{
+		FileName:  fileName,
+		Content:   content,
+		Hash:      SecretFileHash(content),
+		ChunkSize: DefaultSecretFileChunkSize,
+	}, nil
+}
+
+// SecretFileHash returns hex encoded SHA-256 hash of file content
+func SecretFileHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// Chunks returns number of chunks content is uploaded in
+func (u *SecretFileUpload) Chunks() int {
+	return (len(u.Content) + u.ChunkSize - 1) / u.ChunkSize
+}
+
+func (u *SecretFileUpload) chunk(i int) []byte {
+	end := (i + 1) * u.ChunkSize
+	if end > len(u.Content) {
+		end = len(u.Content)
+	}
+	return u.Content[i*u.ChunkSize : end]
+}
+
+// UploadSecretFile uploads file content in chunks and turns the secret into file secret that refers to it.
+// Create or Update has to be called afterwards to store the secret
+func (o *Secret) UploadSecretFile(upload *SecretFileUpload) error {
+	if upload.FilePath == "" {
+		var queryArg = make(map[string]interface{})
+		queryArg["secretName"] = o.SecretName
+		queryArg["fileName"] = upload.FileName
+		queryArg["fileSize"] = len(upload.Content)
+		queryArg["fileHash"] = upload.Hash
+
+		resp, err := o.client.CallGenericMapAPI(o.apiRequestSecretUploadUrl, queryArg)
+		if err != nil {
+			o.client.Logger.Errorf(err.Error())
+			return err
+		}
+		if !resp.Success {
+			o.client.Logger.Errorf(resp.Err().Error())
+			return resp.Err()
+		}
+		path, _ := resp.Result["FilePath"].(string)
+		if path == "" {
+			return fmt.Errorf("Missing upload location for secret file %s", upload.FileName)
+		}
+		upload.FilePath = path
+		upload.Uploaded = 0
+	}
+
+	// Sending the same chunk again overwrites it, so failed chunk upload can be retried
+	ctx := restapi.MarkRetrySafe(o.client.Context())
+	chunks := upload.Chunks()
+	for upload.Uploaded < chunks {
+		i := upload.Uploaded
+		chunk := upload.chunk(i)
+		method := fmt.Sprintf("%s?FilePath=%s&ChunkIndex=%d&ChunkCount=%d&ChunkHash=%s",
+			o.apiUploadSecretFileInChunks, url.QueryEscape(upload.FilePath), i, chunks, SecretFileHash(chunk))
+		resp, err := o.client.UploadFileChunkWithContext(ctx, method, chunk)
+		if err != nil {
+			o.client.Logger.Errorf("%s", err.Error())
+			return fmt.Errorf("Failed to upload chunk %d of %d of secret file %s: %w", i+1, chunks, upload.FileName, err)
+		}
+		if !resp.Success {
+			o.client.Logger.Errorf(resp.Err().Error())
+			return resp.Err()
+		}
+		upload.Uploaded++
+	}
+
+	o.Type = secrettype.File.String()
+	o.SecretFileName = upload.FileName
+	o.SecretFilePath = upload.FilePath
+	o.SecretText = ""
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package platform
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+)
+
+// uploadServer assembles uploaded chunks and fails upload of chunk failAt once
+type uploadServer struct {
+	requests int
+	chunks   map[int][]byte
+	failAt   int
+}
+
+func (s *uploadServer) start(t *testing.T) *httptest.Server {
+	s.chunks = make(map[int][]byte)
+	return restapitest.NewServer(t, map[string]restapitest.Handler{
+		"/ServerManage/RequestSecretUploadUrl": func(r *restapitest.Request) interface{} {
+			s.requests++
+			return map[string]interface{}{"FilePath": "uploads/cert.p12"}
+		},
+		"/ServerManage/UploadSecretFileInChunks": func(r *restapitest.Request) interface{} {
+			query := r.URL.Query()
+			if query.Get("FilePath") != "uploads/cert.p12" {
+				t.Errorf("unexpected upload location %s", query.Get("FilePath"))
+			}
+			i, _ := strconv.Atoi(query.Get("ChunkIndex"))
+			if i == s.failAt {
+				s.failAt = -1
+				return restapitest.Status(http.StatusBadRequest)
+			}
+			if SecretFileHash(r.Body) != query.Get("ChunkHash") {
+				t.Errorf("hash mismatch of chunk %d", i)
+			}
+			s.chunks[i] = r.Body
+			return true
+		},
+	})
+}
+
+func TestUploadSecretFile(t *testing.T) {
+	tenant := &uploadServer{failAt: 1}
+	server := tenant.start(t)
+	defer server.Close()
+	client, _ := restapi.GetNewRestClient(server.URL, server.Client)
+
+	content := bytes.Repeat([]byte("0123456789"), DefaultSecretFileChunkSize/4)
+	upload, err := NewSecretFileUpload("cert.p12", content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upload.Chunks() != 3 {
+		t.Errorf("expected 3 chunks, got %d", upload.Chunks())
+	}
+
+	secret := NewSecret(client)
+	secret.SecretName = "Certificate"
+	if err := secret.UploadSecretFile(upload); err == nil {
+		t.Fatalf("expected upload of chunk 2 to fail")
+	}
+	if upload.Uploaded != 1 {
+		t.Errorf("expected 1 chunk to be uploaded, got %d", upload.Uploaded)
+	}
+
+	// Upload resumes from the failed chunk
+	if err := secret.UploadSecretFile(upload); err != nil {
+		t.Fatal(err)
+	}
+	if tenant.requests != 1 {
+		t.Errorf("expected upload location to be requested once, got %d", tenant.requests)
+	}
+	received := append(append(tenant.chunks[0], tenant.chunks[1]...), tenant.chunks[2]...)
+	if !bytes.Equal(received, content) {
+		t.Errorf("uploaded content doesn't match")
+	}
+	if secret.Type != "File" || secret.SecretFileName != "cert.p12" || secret.SecretFilePath != "uploads/cert.p12" {
+		t.Errorf("unexpected secret %+v", secret)
+	}
+}
+
+func TestNewSecretFileUpload(t *testing.T) {
+	if _, err := NewSecretFileUpload("empty", nil); err == nil {
+		t.Errorf("expected empty content to be rejected")
+	}
+	_, err := NewSecretFileUpload("large", make([]byte, MaxSecretFileSize+1))
+	if err == nil || !strings.Contains(err.Error(), "maximum size") {
+		t.Errorf("expected content over size limit to be rejected, got %v", err)
+	}
+	upload, err := NewSecretFileUpload("hello.txt", []byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upload.Hash != "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824" {
+		t.Errorf("unexpected hash %s", upload.Hash)
+	}
+}
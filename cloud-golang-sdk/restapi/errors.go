@@ -0,0 +1,138 @@
+package restapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError represents a failed Centrify API call. It is returned either when HTTP status isn't 200
+// or when the response body has success set to false.
+type APIError struct {
+	StatusCode int    // HTTP status
+	Success    bool   // success flag of the response body
+	Method     string // API method, e.g. /RedRock/query
+	Message    string
+	Exception  string
+	MessageID  string
+	ErrorCode  string
+}
+
+// HttpError is kept for backward compatibility
+//
+// Deprecated: use APIError instead
+type HttpError = APIError
+
+func (e *APIError) Error() string {
+	msg := e.Message
+	if e.Exception != "" {
+		msg = msg + " " + e.Exception
+	}
+	if e.Method != "" {
+		return fmt.Sprintf("POST to %s failed with code %d, body: %s", e.Method, e.StatusCode, msg)
+	}
+	return msg
+}
+
+// Err returns nil if the response is successful, otherwise returns *APIError built from the response
+func (r *BaseAPIResponse) Err() error {
+	if r.Success {
+		return nil
+	}
+	return &APIError{
+		StatusCode: http.StatusOK,
+		Success:    r.Success,
+		Message:    r.Message,
+		Exception:  r.Exception,
+		MessageID:  r.MessageID,
+		ErrorCode:  r.ErrorCode,
+	}
+}
+
+// newHTTPError returns *APIError for a response with HTTP status other than 200.
+// Details are taken from the body if it is a standard API response.
+func newHTTPError(method string, statusCode int, body []byte) *APIError {
+	e := &APIError{
+		StatusCode: statusCode,
+		Method:     method,
+		Message:    string(body),
+	}
+	reply := &BaseAPIResponse{}
+	if err := json.Unmarshal(body, reply); err == nil {
+		e.Success = reply.Success
+		e.Exception = reply.Exception
+		e.MessageID = reply.MessageID
+		e.ErrorCode = reply.ErrorCode
+		if reply.Message != "" {
+			e.Message = reply.Message
+		}
+	}
+	return e
+}
+
+// NewNotFoundError returns *APIError indicating requested object doesn't exist in tenant.
+// It is used when the API call itself succeeds but returns no object.
+func NewNotFoundError(format string, args ...interface{}) error {
+	return &APIError{
+		StatusCode: http.StatusNotFound,
+		Success:    false,
+		Message:    fmt.Sprintf(format, args...),
+	}
+}
+
+// matches reports whether the error has one of the HTTP status codes or its message contains one of the phrases.
+// Centrify Platform often reports failures with HTTP 200 and success=false so message has to be inspected too.
+func (e *APIError) matches(codes []int, phrases []string) bool {
+	for _, code := range codes {
+		if e.StatusCode == code {
+			return true
+		}
+	}
+	text := strings.ToLower(e.Message + " " + e.Exception)
+	for _, phrase := range phrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+func asAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsNotFound reports whether err indicates the requested object doesn't exist
+func IsNotFound(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.matches([]int{http.StatusNotFound}, []string{"not found", "not exist", "query returns 0 object"})
+}
+
+// IsUnauthorized reports whether err indicates the client isn't authenticated or its token has expired
+func IsUnauthorized(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.matches([]int{http.StatusUnauthorized}, []string{"not authenticated", "unauthenticated", "session expired"})
+}
+
+// IsPermissionDenied reports whether err indicates the caller doesn't have right to perform the operation
+func IsPermissionDenied(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.matches([]int{http.StatusForbidden}, []string{"access denied", "permission denied", "not authorized", "insufficient"})
+}
+
+// IsConflict reports whether err indicates the object already exists or was changed concurrently
+func IsConflict(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.matches([]int{http.StatusConflict}, []string{"already exists", "duplicate"})
+}
+
+// IsThrottled reports whether err indicates the tenant rejected the call due to rate limiting
+func IsThrottled(err error) bool {
+	e, ok := asAPIError(err)
+	return ok && e.matches([]int{http.StatusTooManyRequests}, []string{"throttl", "too many requests"})
+}
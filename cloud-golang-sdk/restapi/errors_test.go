@@ -0,0 +1,44 @@
+package restapi
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIErrorClassification(t *testing.T) {
+	cases := []struct {
+		name  string
+		err   error
+		check func(error) bool
+		want  bool
+	}{
+		{"http 404", newHTTPError("/RedRock/query", http.StatusNotFound, nil), IsNotFound, true},
+		{"client side not found", NewNotFoundError("System does not exist in tenant"), IsNotFound, true},
+		{"wrapped not found", fmt.Errorf("error retrieving Role: %w", NewNotFoundError("Query returns 0 object")), IsNotFound, true},
+		{"success false not found", (&BaseAPIResponse{Message: "Secret not found"}).Err(), IsNotFound, true},
+		{"plain error", fmt.Errorf("System does not exist in tenant"), IsNotFound, false},
+		{"http 401", newHTTPError("/UserMgmt/GetUserInfo", http.StatusUnauthorized, nil), IsUnauthorized, true},
+		{"http 403", newHTTPError("/UserMgmt/GetUserInfo", http.StatusForbidden, nil), IsPermissionDenied, true},
+		{"access denied", (&BaseAPIResponse{Message: "Access denied"}).Err(), IsPermissionDenied, true},
+		{"already exists", (&BaseAPIResponse{Message: "Role test already exists"}).Err(), IsConflict, true},
+		{"http 429", newHTTPError("/RedRock/query", http.StatusTooManyRequests, nil), IsThrottled, true},
+		{"http 500", newHTTPError("/RedRock/query", http.StatusInternalServerError, nil), IsThrottled, false},
+	}
+
+	for _, c := range cases {
+		if got := c.check(c.err); got != c.want {
+			t.Errorf("%s: expected %v, got %v", c.name, c.want, got)
+		}
+	}
+}
+
+func TestHTTPErrorBody(t *testing.T) {
+	err := newHTTPError("/ServerManage/AddResource", http.StatusBadRequest, []byte(`{"success":false,"Message":"Bad request","MessageID":"_I18N_Bad","ErrorCode":"InvalidArg"}`))
+	if err.Message != "Bad request" || err.MessageID != "_I18N_Bad" || err.ErrorCode != "InvalidArg" {
+		t.Fatalf("unexpected error details: %+v", err)
+	}
+	if (&BaseAPIResponse{Success: true}).Err() != nil {
+		t.Fatal("expected nil error for successful response")
+	}
+}
@@ -1,6 +1,8 @@
 package restapi
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -27,6 +29,8 @@ type BaseAPIResponse struct {
 	Result    json.RawMessage
 	Message   string
 	Exception string
+	MessageID string
+	ErrorCode string
 }
 
 type StringResponse struct {
@@ -51,11 +55,6 @@ type SliceResponse struct {
 	Result []interface{}
 }
 
-type HttpError struct {
-	error          // error type
-	StatusCode int // HTTP status
-}
-
 type RestClientMode uint32
 
 // RestClient represents a stateful API client (cookies maintained between calls, single service etc)
@@ -65,6 +64,12 @@ type RestClient struct {
 	Headers         map[string]string
 	SourceHeader    string
 	ResponseHeaders http.Header
+	RetryPolicy     *RetryPolicy   // Retry policy for transient failures. No retry is made if it is nil
+	Throttle        *Throttle      // Client side rate limit and concurrency cap. Requests are not throttled if it is nil
+	TokenSource     TokenSource    // Source of Authorization header. If it is nil, Authorization from Headers is used
+	Logger          *logger.Logger // Logger of the client. Package level logger is used if it is nil
+
+	ctx context.Context // Context applied to requests when none is given explicitly
 }
 
 // GetNewRestClient creates a new RestClient for the specified endpoint.  If a factory for creating
@@ -97,12 +102,43 @@ func GetNewRestClient(service string, httpFactory HttpClientFactory) (*RestClien
 	return client, nil
 }
 
+// WithContext returns a shallow copy of the client whose requests are bound to ctx.
+// Headers, cookies and the underlying http.Client are shared with the original client.
+func (r *RestClient) WithContext(ctx context.Context) *RestClient {
+	if ctx == nil {
+		panic("nil context")
+	}
+	r2 := new(RestClient)
+	*r2 = *r
+	r2.ctx = ctx
+	return r2
+}
+
+// Context returns the context bound to the client. It is context.Background() unless
+// the client was created by WithContext.
+func (r *RestClient) Context() context.Context {
+	if r.ctx != nil {
+		return r.ctx
+	}
+	return context.Background()
+}
+
 func (r *RestClient) CallRawAPI(method string, args map[string]interface{}) ([]byte, error) {
-	return r.postAndGetBody(method, args)
+	return r.CallRawAPIWithContext(r.Context(), method, args)
+}
+
+// CallRawAPIWithContext is like CallRawAPI but the request is bound to ctx
+func (r *RestClient) CallRawAPIWithContext(ctx context.Context, method string, args map[string]interface{}) ([]byte, error) {
+	return r.postAndGetBody(ctx, method, args)
 }
 
 func (r *RestClient) CallBaseAPI(method string, args map[string]interface{}) (*BaseAPIResponse, error) {
-	body, err := r.postAndGetBody(method, args)
+	return r.CallBaseAPIWithContext(r.Context(), method, args)
+}
+
+// CallBaseAPIWithContext is like CallBaseAPI but the request is bound to ctx
+func (r *RestClient) CallBaseAPIWithContext(ctx context.Context, method string, args map[string]interface{}) (*BaseAPIResponse, error) {
+	body, err := r.postAndGetBody(ctx, method, args)
 	if err != nil {
 		return nil, err
 	}
@@ -110,7 +146,12 @@ func (r *RestClient) CallBaseAPI(method string, args map[string]interface{}) (*B
 }
 
 func (r *RestClient) CallGenericMapAPI(method string, args map[string]interface{}) (*GenericMapResponse, error) {
-	body, err := r.postAndGetBody(method, args)
+	return r.CallGenericMapAPIWithContext(r.Context(), method, args)
+}
+
+// CallGenericMapAPIWithContext is like CallGenericMapAPI but the request is bound to ctx
+func (r *RestClient) CallGenericMapAPIWithContext(ctx context.Context, method string, args map[string]interface{}) (*GenericMapResponse, error) {
+	body, err := r.postAndGetBody(ctx, method, args)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +159,12 @@ func (r *RestClient) CallGenericMapAPI(method string, args map[string]interface{
 }
 
 func (r *RestClient) CallStringAPI(method string, args map[string]interface{}) (*StringResponse, error) {
-	body, err := r.postAndGetBody(method, args)
+	return r.CallStringAPIWithContext(r.Context(), method, args)
+}
+
+// CallStringAPIWithContext is like CallStringAPI but the request is bound to ctx
+func (r *RestClient) CallStringAPIWithContext(ctx context.Context, method string, args map[string]interface{}) (*StringResponse, error) {
+	body, err := r.postAndGetBody(ctx, method, args)
 	if err != nil {
 		return nil, err
 	}
@@ -126,7 +172,12 @@ func (r *RestClient) CallStringAPI(method string, args map[string]interface{}) (
 }
 
 func (r *RestClient) CallBoolAPI(method string, args map[string]interface{}) (*BoolResponse, error) {
-	body, err := r.postAndGetBody(method, args)
+	return r.CallBoolAPIWithContext(r.Context(), method, args)
+}
+
+// CallBoolAPIWithContext is like CallBoolAPI but the request is bound to ctx
+func (r *RestClient) CallBoolAPIWithContext(ctx context.Context, method string, args map[string]interface{}) (*BoolResponse, error) {
+	body, err := r.postAndGetBody(ctx, method, args)
 	if err != nil {
 		return nil, err
 	}
@@ -134,38 +185,34 @@ func (r *RestClient) CallBoolAPI(method string, args map[string]interface{}) (*B
 }
 
 func (r *RestClient) CallSliceAPI(method string, args map[string]interface{}) (*SliceResponse, error) {
-	body, err := r.postAndGetBody(method, args)
-	if err != nil {
-		return nil, err
-	}
-	return bodyToSliceResponse(body)
+	return r.CallSliceAPIWithContext(r.Context(), method, args)
 }
 
-func (r *RestClient) postAndGetBody(method string, args map[string]interface{}) ([]byte, error) {
-	postreq, err := r.formHttpRequest(method, args)
+// CallSliceAPIWithContext is like CallSliceAPI but the request is bound to ctx
+func (r *RestClient) CallSliceAPIWithContext(ctx context.Context, method string, args map[string]interface{}) (*SliceResponse, error) {
+	body, err := r.postAndGetBody(ctx, method, args)
 	if err != nil {
-		logger.ErrorTracef(err.Error())
 		return nil, err
 	}
+	return bodyToSliceResponse(body)
+}
 
-	httpresp, err := r.Client.Do(postreq)
+func (r *RestClient) postAndGetBody(ctx context.Context, method string, args map[string]interface{}) ([]byte, error) {
+	httpresp, err := r.doRequest(ctx, method, func() (*http.Request, error) {
+		return r.formHttpRequest(ctx, method, args)
+	})
 	if err != nil {
-		r.ResponseHeaders = nil
-		logger.ErrorTracef(err.Error())
 		return nil, err
 	}
 	defer httpresp.Body.Close()
 
-	// save response heasder
-	r.ResponseHeaders = httpresp.Header
-
 	if httpresp.StatusCode == 200 {
 		body, err := ioutil.ReadAll(httpresp.Body)
 		return body, err
 	}
 
 	body, _ := ioutil.ReadAll(httpresp.Body)
-	return nil, &HttpError{error: fmt.Errorf("POST to %s failed with code %d, body: %s", method, httpresp.StatusCode, body), StatusCode: httpresp.StatusCode}
+	return nil, newHTTPError(method, httpresp.StatusCode, body)
 }
 
 // GetLastResponseHeaders returns the response headers from last REST call
@@ -247,20 +294,43 @@ func payloadFromList(input []map[string]interface{}) string {
 
 // CallGenericMapListAPI is currently used by admin right assignment and removal for Role
 func (r *RestClient) CallGenericMapListAPI(method string, args []map[string]interface{}) (*GenericMapResponse, error) {
-	body, err := r.postAndGetBodyList(method, args)
+	return r.CallGenericMapListAPIWithContext(r.Context(), method, args)
+}
+
+// CallGenericMapListAPIWithContext is like CallGenericMapListAPI but the request is bound to ctx
+func (r *RestClient) CallGenericMapListAPIWithContext(ctx context.Context, method string, args []map[string]interface{}) (*GenericMapResponse, error) {
+	body, err := r.postAndGetBodyList(ctx, method, args)
 	if err != nil {
 		return nil, err
 	}
 	return bodyToGenericMapResponse(body)
 }
 
-func (r *RestClient) postAndGetBodyList(method string, args []map[string]interface{}) ([]byte, error) {
+func (r *RestClient) postAndGetBodyList(ctx context.Context, method string, args []map[string]interface{}) ([]byte, error) {
+	httpresp, err := r.doRequest(ctx, method, func() (*http.Request, error) {
+		return r.formHttpListRequest(ctx, method, args)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	defer httpresp.Body.Close()
+
+	if httpresp.StatusCode == 200 {
+		return ioutil.ReadAll(httpresp.Body)
+	}
+
+	body, _ := ioutil.ReadAll(httpresp.Body)
+	return nil, newHTTPError(method, httpresp.StatusCode, body)
+}
+
+func (r *RestClient) formHttpListRequest(ctx context.Context, method string, args []map[string]interface{}) (*http.Request, error) {
 	service := strings.TrimSuffix(r.Service, "/")
 	method = strings.TrimPrefix(method, "/")
 	postdata := strings.NewReader(payloadFromList(args))
-	logger.Debugf("Post url: %s", service+"/"+method)
-	logger.Debugf("Post json: %+v", postdata)
-	postreq, err := http.NewRequest("POST", service+"/"+method, postdata)
+	r.Logger.Debugf("Post url: %s", service+"/"+method)
+	r.Logger.Debugf("Post json: %+v", postdata)
+	postreq, err := http.NewRequestWithContext(ctx, "POST", service+"/"+method, postdata)
 
 	if err != nil {
 		return nil, err
@@ -274,44 +344,23 @@ func (r *RestClient) postAndGetBodyList(method string, args []map[string]interfa
 		postreq.Header.Add(k, v)
 	}
 
-	httpresp, err := r.Client.Do(postreq)
-	if err != nil {
-		r.ResponseHeaders = nil
-		logger.ErrorTracef(err.Error())
-		return nil, err
-	}
-
-	defer httpresp.Body.Close()
-
-	// save response heasder
-	r.ResponseHeaders = httpresp.Header
-
-	if httpresp.StatusCode == 200 {
-		return ioutil.ReadAll(httpresp.Body)
-	}
-
-	body, _ := ioutil.ReadAll(httpresp.Body)
-	return nil, &HttpError{error: fmt.Errorf("POST to %s failed with code %d, body: %s", method, httpresp.StatusCode, body), StatusCode: httpresp.StatusCode}
+	return postreq, nil
 }
 
 func (r *RestClient) DownloadFile(method string, args map[string]interface{}, filepath string) error {
-	postreq, err := r.formHttpRequest(method, args)
-	if err != nil {
-		logger.ErrorTracef(err.Error())
-		return err
-	}
+	return r.DownloadFileWithContext(r.Context(), method, args, filepath)
+}
 
-	httpresp, err := r.Client.Do(postreq)
+// DownloadFileWithContext is like DownloadFile but the request is bound to ctx
+func (r *RestClient) DownloadFileWithContext(ctx context.Context, method string, args map[string]interface{}, filepath string) error {
+	httpresp, err := r.doRequest(ctx, method, func() (*http.Request, error) {
+		return r.formHttpRequest(ctx, method, args)
+	})
 	if err != nil {
-		r.ResponseHeaders = nil
-		logger.ErrorTracef(err.Error())
 		return err
 	}
 	defer httpresp.Body.Close()
 
-	// save response heasder
-	r.ResponseHeaders = httpresp.Header
-
 	if httpresp.StatusCode == 200 {
 		// Create the file
 		out, err := os.Create(filepath)
@@ -330,16 +379,65 @@ func (r *RestClient) DownloadFile(method string, args map[string]interface{}, fi
 	return nil
 }
 
-func (r *RestClient) formHttpRequest(method string, args map[string]interface{}) (*http.Request, error) {
+// UploadFileChunk posts a chunk of file content as raw request body
+func (r *RestClient) UploadFileChunk(method string, chunk []byte) (*BaseAPIResponse, error) {
+	return r.UploadFileChunkWithContext(r.Context(), method, chunk)
+}
+
+// UploadFileChunkWithContext is like UploadFileChunk but the request is bound to ctx
+func (r *RestClient) UploadFileChunkWithContext(ctx context.Context, method string, chunk []byte) (*BaseAPIResponse, error) {
+	httpresp, err := r.doRequest(ctx, method, func() (*http.Request, error) {
+		return r.formHttpUploadRequest(ctx, method, chunk)
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer httpresp.Body.Close()
+
+	body, err := ioutil.ReadAll(httpresp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if httpresp.StatusCode != 200 {
+		return nil, newHTTPError(method, httpresp.StatusCode, body)
+	}
+	return bodyToBaseAPIResponse(body)
+}
+
+func (r *RestClient) formHttpUploadRequest(ctx context.Context, method string, chunk []byte) (*http.Request, error) {
+	service := strings.TrimSuffix(r.Service, "/")
+	method = strings.TrimPrefix(method, "/")
+	r.Logger.Debugf("Post url: %s", service+"/"+method)
+	r.Logger.Debugf("Post %d bytes of file content", len(chunk))
+	// Body is created for each attempt so that retried request sends the whole chunk again
+	postreq, err := http.NewRequestWithContext(ctx, "POST", service+"/"+method, bytes.NewReader(chunk))
+
+	if err != nil {
+		r.Logger.ErrorTracef(err.Error())
+		return nil, err
+	}
+
+	postreq.Header.Add("Content-Type", "application/octet-stream")
+	postreq.Header.Add("X-CENTRIFY-NATIVE-CLIENT", "Yes")
+	postreq.Header.Add("X-CFY-SRC", r.SourceHeader)
+
+	for k, v := range r.Headers {
+		postreq.Header.Add(k, v)
+	}
+
+	return postreq, nil
+}
+
+func (r *RestClient) formHttpRequest(ctx context.Context, method string, args map[string]interface{}) (*http.Request, error) {
 	service := strings.TrimSuffix(r.Service, "/")
 	method = strings.TrimPrefix(method, "/")
 	postdata := strings.NewReader(payloadFromMap(args))
-	logger.Debugf("Post url: %s", service+"/"+method)
-	logger.Debugf("Post json: %+v", postdata)
-	postreq, err := http.NewRequest("POST", service+"/"+method, postdata)
+	r.Logger.Debugf("Post url: %s", service+"/"+method)
+	r.Logger.Debugf("Post json: %+v", postdata)
+	postreq, err := http.NewRequestWithContext(ctx, "POST", service+"/"+method, postdata)
 
 	if err != nil {
-		logger.ErrorTracef(err.Error())
+		r.Logger.ErrorTracef(err.Error())
 		return nil, err
 	}
 
@@ -0,0 +1,73 @@
+// Package restapitest provides a fake tenant for testing code that calls Centrify APIs
+package restapitest
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+)
+
+// Request is an API call received by the fake tenant
+type Request struct {
+	*http.Request
+	Body []byte                 // Raw request body
+	Args map[string]interface{} // Request body decoded as JSON object. It is nil if body isn't one
+}
+
+// Decode decodes JSON request body into v
+func (r *Request) Decode(v interface{}) error {
+	return json.Unmarshal(r.Body, v)
+}
+
+// Handler answers an API call. Returned value is sent as Result of a successful API response,
+// except Status which is sent as HTTP status of the response instead
+type Handler func(r *Request) interface{}
+
+// Status is returned by Handler to answer the call with HTTP status other than 200
+type Status int
+
+// NewServer starts fake tenant that answers each API call with the handler of its URL path.
+// Calls to any other path fail the test. Caller is responsible for closing the server
+func NewServer(t *testing.T, handlers map[string]Handler) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handler, ok := handlers[r.URL.Path]
+		if !ok {
+			t.Errorf("unexpected request %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("reading request %s: %v", r.URL.Path, err)
+		}
+		req := &Request{Request: r, Body: body}
+		json.Unmarshal(body, &req.Args)
+
+		result := handler(req)
+		if status, ok := result.(Status); ok {
+			w.WriteHeader(int(status))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"success": true, "Result": result})
+	}))
+}
+
+// NewClient returns REST client that calls server
+func NewClient(server *httptest.Server) *restapi.RestClient {
+	client, err := restapi.GetNewRestClient(server.URL, server.Client)
+	if err != nil {
+		panic(err)
+	}
+	return client
+}
+
+// Result returns handler that answers every call with result
+func Result(result interface{}) Handler {
+	return func(*Request) interface{} {
+		return result
+	}
+}
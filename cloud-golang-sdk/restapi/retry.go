@@ -0,0 +1,211 @@
+package restapi
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy represents how transient failures of idempotent calls are retried
+type RetryPolicy struct {
+	MaxAttempts int           // Total number of attempts including the first one. 0 or 1 disables retry
+	MinBackoff  time.Duration // Wait time before the first retry
+	MaxBackoff  time.Duration // Upper bound of wait time between retries, including wait time requested by Retry-After
+}
+
+// DefaultRetryPolicy returns a retry policy that makes up to 3 attempts with backoff between 1 and 30 seconds
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts: 3,
+		MinBackoff:  1 * time.Second,
+		MaxBackoff:  30 * time.Second,
+	}
+}
+
+type retrySafeKey struct{}
+
+// MarkRetrySafe returns a copy of ctx indicating that calls made with it may be retried
+// even though the API method is not recognized as idempotent
+func MarkRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// isRetrySafe reports whether the API method may be sent more than once.
+// Read-only methods such as /RedRock/query, /ServerManage/GetResourcePermissions are
+// idempotent. Any other method has to be explicitly marked by MarkRetrySafe.
+func isRetrySafe(ctx context.Context, method string) bool {
+	if safe, ok := ctx.Value(retrySafeKey{}).(bool); ok && safe {
+		return true
+	}
+
+	method = strings.ToLower(strings.Trim(method, "/"))
+	if method == "redrock/query" {
+		return true
+	}
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range []string{"get", "read", "query", "list"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRetryableStatus reports whether the HTTP status indicates a transient condition
+func isRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// isTransientError reports whether error returned by http.Client is worth retrying
+func isTransientError(err error) bool {
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return false
+}
+
+// backoff returns wait time before the given retry attempt (starting from 1), using exponential backoff with jitter
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	wait := p.MinBackoff
+	for i := 1; i < attempt && wait < p.MaxBackoff; i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+	// Randomize between half and full wait time so that parallel callers do not retry in lockstep
+	half := wait / 2
+	return half + time.Duration(rand.Int63n(int64(wait-half)+1))
+}
+
+// retryAfter parses Retry-After header which is either in seconds or HTTP date
+func retryAfter(header http.Header) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// doRequest sends the request built by newRequest. Transient failures of retry safe calls are
+// retried according to the client's RetryPolicy. If the client has a TokenSource, the request
+// is sent once more with a new token after 401 response. Caller is responsible for closing response body.
+func (r *RestClient) doRequest(ctx context.Context, method string, newRequest func() (*http.Request, error)) (*http.Response, error) {
+	maxAttempts := 1
+	if r.RetryPolicy != nil && r.RetryPolicy.MaxAttempts > 1 && isRetrySafe(ctx, method) {
+		maxAttempts = r.RetryPolicy.MaxAttempts
+	}
+
+	reauthenticated := false
+	for attempt := 1; ; attempt++ {
+		postreq, err := newRequest()
+		if err != nil {
+			r.Logger.ErrorTracef(err.Error())
+			return nil, err
+		}
+
+		var token *Token
+		if r.TokenSource != nil {
+			token, err = r.TokenSource.Token(ctx)
+			if err != nil {
+				r.Logger.ErrorTracef(err.Error())
+				return nil, err
+			}
+			postreq.Header.Set("Authorization", token.AuthorizationHeader())
+		}
+
+		release := func() {}
+		if r.Throttle != nil {
+			release, err = r.Throttle.wait(ctx, method, r.Logger)
+			if err != nil {
+				r.Logger.ErrorTracef(err.Error())
+				return nil, err
+			}
+		}
+
+		httpresp, err := r.Client.Do(postreq)
+		var wait time.Duration
+		if err != nil {
+			release()
+			r.ResponseHeaders = nil
+			if attempt >= maxAttempts || ctx.Err() != nil || !isTransientError(err) {
+				r.Logger.ErrorTracef(err.Error())
+				return nil, err
+			}
+			wait = r.RetryPolicy.backoff(attempt)
+			r.Logger.Infof("Attempt %d/%d of POST to %s failed: %v. Retrying in %v", attempt, maxAttempts, method, err, wait)
+		} else {
+			// save response heasder
+			r.ResponseHeaders = httpresp.Header
+			if httpresp.StatusCode == http.StatusUnauthorized && token != nil && !reauthenticated {
+				// Token may have been expired or revoked. Get a new one and send the request once more.
+				// The request wasn't processed by server so it is safe to repeat it regardless of method.
+				io.Copy(ioutil.Discard, httpresp.Body)
+				httpresp.Body.Close()
+				release()
+				r.Logger.Infof("POST to %s was rejected with code %d, re-authenticating", method, httpresp.StatusCode)
+				r.TokenSource.Invalidate(token)
+				reauthenticated = true
+				attempt--
+				continue
+			}
+			if attempt >= maxAttempts || !isRetryableStatus(httpresp.StatusCode) {
+				httpresp.Body = &releaseOnClose{ReadCloser: httpresp.Body, release: release}
+				return httpresp, nil
+			}
+			// Drain body so that connection can be reused
+			io.Copy(ioutil.Discard, httpresp.Body)
+			httpresp.Body.Close()
+			release()
+
+			wait = r.RetryPolicy.backoff(attempt)
+			if d, ok := retryAfter(httpresp.Header); ok {
+				wait = d
+				if r.RetryPolicy.MaxBackoff > 0 && wait > r.RetryPolicy.MaxBackoff {
+					wait = r.RetryPolicy.MaxBackoff
+				}
+			}
+			r.Logger.Infof("Attempt %d/%d of POST to %s failed with code %d. Retrying in %v", attempt, maxAttempts, method, httpresp.StatusCode, wait)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			r.Logger.ErrorTracef(ctx.Err().Error())
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) (*RestClient, func()) {
+	server := httptest.NewTLSServer(handler)
+	client, err := GetNewRestClient(server.URL, server.Client)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	client.RetryPolicy = &RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+	return client, server.Close
+}
+
+func TestRetryTransientStatus(t *testing.T) {
+	var calls int32
+	client, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"success":true,"Result":{}}`))
+	})
+	defer done()
+
+	resp, err := client.CallGenericMapAPI("/RedRock/query", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !resp.Success || calls != 3 {
+		t.Fatalf("expected success after 3 attempts, got success=%v after %d", resp.Success, calls)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var calls int32
+	client, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+	defer done()
+
+	_, err := client.CallGenericMapAPI("/UserMgmt/GetUserInfo", nil)
+	httpErr, ok := err.(*HttpError)
+	if !ok || httpErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("expected HttpError with code 429, got %v", err)
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestNoRetryForUnsafeMethod(t *testing.T) {
+	var calls int32
+	client, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+	defer done()
+
+	if _, err := client.CallGenericMapAPI("/ServerManage/AddResource", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 attempt, got %d", calls)
+	}
+
+	calls = 0
+	if _, err := client.CallGenericMapAPIWithContext(MarkRetrySafe(context.Background()), "/ServerManage/AddResource", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 attempts for call marked safe, got %d", calls)
+	}
+}
+
+func TestRetryStopsOnContextCancel(t *testing.T) {
+	client, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer done()
+	client.RetryPolicy.MinBackoff = time.Minute
+	client.RetryPolicy.MaxBackoff = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := client.WithContext(ctx).CallGenericMapAPI("/RedRock/query", nil)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
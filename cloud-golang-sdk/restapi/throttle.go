@@ -0,0 +1,116 @@
+package restapi
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	logger "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
+)
+
+// Throttle limits request rate with a token bucket and caps number of requests in flight.
+// A single Throttle is meant to be shared by all goroutines using the same RestClient.
+type Throttle struct {
+	mu       sync.Mutex
+	rate     float64   // tokens added per second. 0 means unlimited rate
+	burst    float64   // bucket size
+	tokens   float64   // available tokens, negative when callers are queued
+	last     time.Time // last time tokens were updated
+	inflight chan struct{}
+}
+
+// NewThrottle creates a Throttle that allows requestsPerSecond requests per second with bursts up to burst
+// and at most maxInFlight concurrent requests. Zero value of any argument means no limit on that dimension.
+func NewThrottle(requestsPerSecond float64, burst int, maxInFlight int) *Throttle {
+	t := &Throttle{
+		rate: requestsPerSecond,
+		last: time.Now(),
+	}
+	if requestsPerSecond > 0 {
+		t.burst = float64(burst)
+		if t.burst < 1 {
+			t.burst = 1
+		}
+		t.tokens = t.burst
+	}
+	if maxInFlight > 0 {
+		t.inflight = make(chan struct{}, maxInFlight)
+	}
+	return t
+}
+
+// wait blocks until a request may be sent or ctx is done. The returned function must be called
+// to release the in flight slot once the request completes.
+func (t *Throttle) wait(ctx context.Context, method string, log *logger.Logger) (func(), error) {
+	start := time.Now()
+	release := func() {}
+
+	if t.inflight != nil {
+		select {
+		case t.inflight <- struct{}{}:
+			release = func() { <-t.inflight }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if delay := t.reserve(); delay > 0 {
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			t.cancelReservation()
+			release()
+			return nil, ctx.Err()
+		}
+	}
+
+	if queued := time.Since(start); queued > time.Millisecond {
+		log.Debugf("POST to %s waited %v in client side queue", method, queued)
+	}
+	return release, nil
+}
+
+// reserve takes a token from the bucket and returns how long caller must wait before using it
+func (t *Throttle) reserve() time.Duration {
+	if t.rate <= 0 {
+		return 0
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	t.tokens += now.Sub(t.last).Seconds() * t.rate
+	if t.tokens > t.burst {
+		t.tokens = t.burst
+	}
+	t.last = now
+	t.tokens--
+	if t.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-t.tokens / t.rate * float64(time.Second))
+}
+
+// cancelReservation returns the token taken by a caller that gave up waiting
+func (t *Throttle) cancelReservation() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.tokens++
+}
+
+// releaseOnClose releases in flight slot when response body is closed
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
@@ -0,0 +1,60 @@
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestThrottleMaxInFlight(t *testing.T) {
+	var current, peak int32
+	client, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&current, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&current, -1)
+		w.Write([]byte(`{"success":true}`))
+	})
+	defer done()
+	client.Throttle = NewThrottle(0, 0, 2)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Each operation works on its own copy of the client, sharing the same Throttle
+			if _, err := client.WithContext(context.Background()).CallBaseAPI("/RedRock/query", nil); err != nil {
+				t.Errorf("err: %s", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if peak > 2 {
+		t.Fatalf("expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func TestThrottleRate(t *testing.T) {
+	throttle := NewThrottle(50, 1, 0)
+
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		if d := throttle.reserve(); d > 0 {
+			time.Sleep(d)
+		}
+	}
+	// First request uses the burst token, remaining 5 are spaced 20ms apart
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Fatalf("expected requests to be spread over at least 100ms, took %v", elapsed)
+	}
+}
@@ -0,0 +1,31 @@
+package restapi
+
+import (
+	"context"
+	"time"
+)
+
+// Token represents an access token sent in Authorization header
+type Token struct {
+	AccessToken string
+	TokenType   string
+	Expiry      time.Time // Zero value means the token doesn't expire
+}
+
+// AuthorizationHeader returns value of Authorization header for the token
+func (t *Token) AuthorizationHeader() string {
+	tokenType := t.TokenType
+	if tokenType == "" {
+		tokenType = "Bearer"
+	}
+	return tokenType + " " + t.AccessToken
+}
+
+// TokenSource supplies access tokens to RestClient. Implementations must be safe for concurrent use
+// since all copies of a RestClient share the same TokenSource.
+type TokenSource interface {
+	// Token returns a valid token, obtaining a new one if the current token is about to expire
+	Token(ctx context.Context) (*Token, error)
+	// Invalidate tells the source that the server rejected the token so that next call to Token obtains a new one
+	Invalidate(rejected *Token)
+}
@@ -0,0 +1,74 @@
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+// fakeTokenSource issues numbered tokens and renews only when current token is invalidated
+type fakeTokenSource struct {
+	mu     sync.Mutex
+	issued int
+	token  *Token
+}
+
+func (f *fakeTokenSource) Token(ctx context.Context) (*Token, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.token == nil {
+		f.issued++
+		f.token = &Token{AccessToken: fmt.Sprintf("token%d", f.issued), TokenType: "Bearer"}
+	}
+	return f.token, nil
+}
+
+func (f *fakeTokenSource) Invalidate(rejected *Token) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.token == rejected {
+		f.token = nil
+	}
+}
+
+func TestReauthenticateOnUnauthorized(t *testing.T) {
+	client, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`{"success":true}`))
+	})
+	defer done()
+	ts := &fakeTokenSource{}
+	client.TokenSource = ts
+
+	// Update calls are not retry safe but 401 means the request wasn't processed
+	resp, err := client.CallBaseAPI("/SaasManage/UpdateApplicationDE", nil)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if !resp.Success || ts.issued != 2 {
+		t.Fatalf("expected success with second token, got success=%v after %d tokens", resp.Success, ts.issued)
+	}
+}
+
+func TestReauthenticateOnlyOnce(t *testing.T) {
+	var calls int
+	client, done := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	defer done()
+	client.TokenSource = &fakeTokenSource{}
+
+	_, err := client.CallBaseAPI("/RedRock/query", nil)
+	if !IsUnauthorized(err) {
+		t.Fatalf("expected unauthorized error, got %v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected 2 attempts, got %d", calls)
+	}
+}
@@ -0,0 +1,250 @@
+// Package tokencache implements an encrypted on-disk cache of access tokens so that
+// command line tools calling the SDK repeatedly authenticate only once.
+package tokencache
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// PassphraseEnv is environment variable holding passphrase used to encrypt cache entries
+	PassphraseEnv = "CENTRIFY_TOKENCACHE_PASSPHRASE"
+	// DefaultTTL is how long an entry is kept when token expiry isn't known
+	DefaultTTL = 30 * time.Minute
+
+	fileExt   = ".token"
+	saltSize  = 16
+	fileMagic = "CTC1"
+)
+
+// Key identifies a cache entry
+type Key struct {
+	URL      string // Tenant URL
+	AuthType string // Authentication type
+	User     string // User or OAuth2 client id
+	Scope    string // OAuth2 or DMC scope
+}
+
+// fileName returns name of the file holding the entry. Key is hashed so that user names are not revealed.
+func (k Key) fileName() string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{strings.ToLower(strings.TrimRight(k.URL, "/")), strings.ToLower(k.AuthType), strings.ToLower(k.User), k.Scope}, "\n")))
+	return hex.EncodeToString(sum[:]) + fileExt
+}
+
+// Entry represents a cached token
+type Entry struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	Expiry      time.Time `json:"expiry"`
+}
+
+// Cache stores entries as files readable only by the owner, encrypted with AES-GCM using key derived from passphrase
+type Cache struct {
+	dir        string
+	passphrase []byte
+}
+
+// DefaultDir returns directory of the cache under user's cache directory
+func DefaultDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "centrify", "tokens"), nil
+}
+
+// New returns Cache stored in dir, or in DefaultDir if dir is empty. If passphrase is empty, it is read from
+// CENTRIFY_TOKENCACHE_PASSPHRASE or, as the last resort, derived from the current user and host.
+func New(dir string, passphrase string) (*Cache, error) {
+	if dir == "" {
+		var err error
+		dir, err = DefaultDir()
+		if err != nil {
+			return nil, fmt.Errorf("Unable to determine token cache directory: %v", err)
+		}
+	}
+	if passphrase == "" {
+		passphrase = os.Getenv(PassphraseEnv)
+	}
+	if passphrase == "" {
+		passphrase = defaultPassphrase()
+	}
+	return &Cache{dir: dir, passphrase: []byte(passphrase)}, nil
+}
+
+// defaultPassphrase binds cache entries to the current user on the current host. It only prevents
+// entries from being reused elsewhere, set CENTRIFY_TOKENCACHE_PASSPHRASE for real protection.
+func defaultPassphrase() string {
+	host, _ := os.Hostname()
+	parts := []string{"centrify-token-cache", host}
+	if u, err := user.Current(); err == nil {
+		parts = append(parts, u.Uid, u.Username, u.HomeDir)
+	}
+	return strings.Join(parts, "|")
+}
+
+// deriveKey derives AES-256 key from passphrase and salt
+func (c *Cache) deriveKey(salt []byte) ([]byte, error) {
+	return scrypt.Key(c.passphrase, salt, 1<<15, 8, 1, 32)
+}
+
+// Load returns cached entry for key. It returns nil if there is no entry, the entry has expired
+// or it can't be decrypted, in which case the caller is expected to authenticate again.
+func (c *Cache) Load(key Key) (*Entry, error) {
+	path := filepath.Join(c.dir, key.fileName())
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	entry, err := c.decrypt(data)
+	if err != nil {
+		log.Infof("Discarding token cache entry %s: %v", path, err)
+		os.Remove(path)
+		return nil, nil
+	}
+	if !entry.Expiry.IsZero() && time.Now().After(entry.Expiry) {
+		log.Debugf("Token cache entry %s has expired", path)
+		os.Remove(path)
+		return nil, nil
+	}
+	return entry, nil
+}
+
+// Store saves entry for key. Entry without expiry is kept for DefaultTTL.
+func (c *Cache) Store(key Key, entry *Entry) error {
+	if entry.Expiry.IsZero() {
+		e := *entry
+		e.Expiry = time.Now().Add(DefaultTTL)
+		entry = &e
+	}
+	data, err := c.encrypt(entry)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(c.dir, 0700); err != nil {
+		return err
+	}
+
+	// Write to a temporary file first so that concurrent readers never see partial content.
+	// TempFile creates the file with 0600 permission.
+	tmp, err := ioutil.TempFile(c.dir, "tmp-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(c.dir, key.fileName()))
+}
+
+// Delete removes cached entry for key
+func (c *Cache) Delete(key Key) error {
+	err := os.Remove(filepath.Join(c.dir, key.fileName()))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Purge removes all cached entries
+func (c *Cache) Purge() error {
+	files, err := filepath.Glob(filepath.Join(c.dir, "*"+fileExt))
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// encrypt returns magic | salt | nonce | AES-GCM sealed JSON of entry
+func (c *Cache) encrypt(entry *Entry) ([]byte, error) {
+	plain, err := json.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	gcm, err := c.newGCM(salt)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(fileMagic)
+	buf.Write(salt)
+	buf.Write(nonce)
+	buf.Write(gcm.Seal(nil, nonce, plain, []byte(fileMagic)))
+	return buf.Bytes(), nil
+}
+
+func (c *Cache) decrypt(data []byte) (*Entry, error) {
+	if len(data) < len(fileMagic)+saltSize || string(data[:len(fileMagic)]) != fileMagic {
+		return nil, fmt.Errorf("unrecognized file format")
+	}
+	data = data[len(fileMagic):]
+	gcm, err := c.newGCM(data[:saltSize])
+	if err != nil {
+		return nil, err
+	}
+	data = data[saltSize:]
+	if len(data) < gcm.NonceSize() {
+		return nil, fmt.Errorf("unrecognized file format")
+	}
+	plain, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], []byte(fileMagic))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt: %v", err)
+	}
+
+	entry := &Entry{}
+	if err := json.Unmarshal(plain, entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (c *Cache) newGCM(salt []byte) (cipher.AEAD, error) {
+	key, err := c.deriveKey(salt)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,106 @@
+package tokencache
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func newTestCache(t *testing.T, passphrase string) (*Cache, func()) {
+	dir, err := ioutil.TempDir("", "tokencache")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	cache, err := New(filepath.Join(dir, "tokens"), passphrase)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	return cache, func() { os.RemoveAll(dir) }
+}
+
+func TestStoreAndLoad(t *testing.T) {
+	cache, done := newTestCache(t, "secret")
+	defer done()
+	key := Key{URL: "https://tenant.my.centrify.net", AuthType: "oauth", User: "client@tenant", Scope: "all"}
+
+	if err := cache.Store(key, &Entry{AccessToken: "token", TokenType: "Bearer", Expiry: time.Now().Add(time.Hour)}); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(cache.dir, "*"+fileExt))
+	if len(files) != 1 {
+		t.Fatalf("expected 1 cache file, got %d", len(files))
+	}
+	data, _ := ioutil.ReadFile(files[0])
+	if string(data) == "" || filepath.Base(files[0]) != key.fileName() {
+		t.Fatalf("unexpected cache file %s", files[0])
+	}
+	for _, s := range []string{"token", "client@tenant"} {
+		if bytes.Contains(data, []byte(s)) {
+			t.Fatalf("cache file contains %q in clear text", s)
+		}
+	}
+	if runtime.GOOS != "windows" {
+		info, _ := os.Stat(files[0])
+		if info.Mode().Perm() != 0600 {
+			t.Fatalf("expected file permission 0600, got %v", info.Mode().Perm())
+		}
+	}
+
+	// Key matching is case insensitive for URL, auth type and user
+	entry, err := cache.Load(Key{URL: "https://TENANT.my.centrify.net/", AuthType: "OAuth", User: "Client@Tenant", Scope: "all"})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if entry == nil || entry.AccessToken != "token" {
+		t.Fatalf("expected cached token, got %+v", entry)
+	}
+
+	// Different scope is a different entry
+	if entry, _ := cache.Load(Key{URL: key.URL, AuthType: key.AuthType, User: key.User, Scope: "other"}); entry != nil {
+		t.Fatalf("expected no entry for different scope")
+	}
+
+	if err := cache.Delete(key); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if entry, _ := cache.Load(key); entry != nil {
+		t.Fatalf("expected entry to be deleted")
+	}
+}
+
+func TestExpiredAndUndecryptableEntries(t *testing.T) {
+	cache, done := newTestCache(t, "secret")
+	defer done()
+	expired := Key{URL: "https://tenant", AuthType: "unpw", User: "expired"}
+	other := Key{URL: "https://tenant", AuthType: "unpw", User: "other"}
+
+	cache.Store(expired, &Entry{AccessToken: "token", Expiry: time.Now().Add(-time.Minute)})
+	if entry, _ := cache.Load(expired); entry != nil {
+		t.Fatalf("expected expired entry to be discarded")
+	}
+
+	cache.Store(other, &Entry{AccessToken: "token"})
+	wrong := &Cache{dir: cache.dir, passphrase: []byte("wrong")}
+	if entry, _ := wrong.Load(other); entry != nil {
+		t.Fatalf("expected entry encrypted with another passphrase to be discarded")
+	}
+}
+
+func TestPurge(t *testing.T) {
+	cache, done := newTestCache(t, "secret")
+	defer done()
+	for _, user := range []string{"a", "b", "c"} {
+		cache.Store(Key{URL: "https://tenant", AuthType: "dmc", User: user}, &Entry{AccessToken: user})
+	}
+	if err := cache.Purge(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if files, _ := filepath.Glob(filepath.Join(cache.dir, "*")); len(files) != 0 {
+		t.Fatalf("expected no files left, got %v", files)
+	}
+}
@@ -1,14 +1,22 @@
 package utils
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
+	"net/http"
 	"strings"
+	"sync"
+	"syscall"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/dmc"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/authenticationtype"
+	log "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/oauth"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/tokencache"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/webcookie"
+	"golang.org/x/crypto/ssh/terminal"
 )
 
 // VaultClient represents vault client structure
@@ -22,11 +30,36 @@ type VaultClient struct {
 	User     string              // User to run the command as (or OAuth2 client if requesting a token)
 	Password string              // Password for user (or OAuth2 client secret if requesting a token)
 	Skipcert bool                // Whether to skip certificate validation
+	Port     int                 // Loopback port receiving OAuth2 authorization code redirect. 0 means any free port
 	Debug    bool
+	Cache    *tokencache.Cache // Token cache. Authentication result is reused across invocations if it is set
 }
 
 // authenticate authenticates to tenant and save reset client
 func (c *VaultClient) authenticate() error {
+	if c.Cache != nil && c.Token == "" {
+		restClient, err := c.clientFromCache()
+		if err != nil {
+			log.Infof("Unable to use token cache: %v", err)
+		} else if restClient != nil {
+			c.client = restClient
+			return nil
+		}
+	}
+
+	restClient, err := c.login()
+	if err != nil {
+		return err
+	}
+	c.client = restClient
+	if c.Cache != nil && c.Token == "" {
+		c.storeToken(restClient)
+	}
+	return nil
+}
+
+// login authenticates to tenant with the configured authentication type and returns authenticated REST client
+func (c *VaultClient) login() (*restapi.RestClient, error) {
 	var restClient *restapi.RestClient
 	var err error
 	switch strings.ToLower(c.AuthType) {
@@ -42,7 +75,24 @@ func (c *VaultClient) authenticate() error {
 		}
 		restClient, err = call.GetClient()
 		if err != nil {
-			return fmt.Errorf("Unable to get oauth rest client: %v", err)
+			return nil, fmt.Errorf("Unable to get oauth rest client: %v", err)
+		}
+	case authenticationtype.OAuth2PKCE.String(), authenticationtype.OAuth2DeviceCode.String():
+		call := oauth.OauthClient{
+			Service:        c.URL,
+			AppID:          c.AppID,
+			Scope:          c.Scope,
+			ClientID:       c.User,
+			SkipCertVerify: c.Skipcert,
+			RedirectPort:   c.Port,
+		}
+		if strings.ToLower(c.AuthType) == authenticationtype.OAuth2PKCE.String() {
+			restClient, err = call.GetPKCEClient()
+		} else {
+			restClient, err = call.GetDeviceCodeClient()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Unable to get oauth rest client: %v", err)
 		}
 	case authenticationtype.UsernamePassword.String():
 		call := webcookie.WebCookie{}
@@ -50,10 +100,17 @@ func (c *VaultClient) authenticate() error {
 		call.ClientID = c.User
 		call.ClientSecret = c.Password
 		call.SkipCertVerify = c.Skipcert
+		// Challenges are answered from environment first, then prompted on terminal if there is one
+		responder := webcookie.ResponderFromEnv(c.Password)
+		if terminal.IsTerminal(int(syscall.Stdin)) {
+			responder = append(responder, webcookie.TTYResponder{})
+		}
+		call.Responder = responder
+		call.MechanismPreference = webcookie.MechanismPreferenceFromEnv()
 
 		restClient, err = call.GetClient()
 		if err != nil {
-			return fmt.Errorf("Unable to get simple rest client: %v", err)
+			return nil, fmt.Errorf("Unable to get simple rest client: %v", err)
 		}
 	case authenticationtype.DelegatedMachineCredential.String():
 		call := dmc.DMC{}
@@ -64,13 +121,12 @@ func (c *VaultClient) authenticate() error {
 
 		restClient, err = call.GetClient()
 		if err != nil {
-			return fmt.Errorf("Unable to get DMC rest client: %v", err)
+			return nil, fmt.Errorf("Unable to get DMC rest client: %v", err)
 		}
 	default:
-		return fmt.Errorf("Invalid authentication type: %v", c.AuthType)
+		return nil, fmt.Errorf("Invalid authentication type: %v", c.AuthType)
 	}
-	c.client = restClient
-	return nil
+	return restClient, nil
 }
 
 // GetClient returns REST client
@@ -83,3 +139,146 @@ func (c *VaultClient) GetClient() (*restapi.RestClient, error) {
 	}
 	return c.client, nil
 }
+
+// Logout removes cached token of the tenant, authentication type, user and scope
+func (c *VaultClient) Logout() error {
+	c.client = nil
+	if c.Cache == nil {
+		return nil
+	}
+	return c.Cache.Delete(c.cacheKey())
+}
+
+func (c *VaultClient) cacheKey() tokencache.Key {
+	return tokencache.Key{
+		URL:      c.URL,
+		AuthType: c.AuthType,
+		User:     c.User,
+		Scope:    c.Scope,
+	}
+}
+
+// clientFromCache returns REST client using cached token or nil if there is no valid cached token
+func (c *VaultClient) clientFromCache() (*restapi.RestClient, error) {
+	key := c.cacheKey()
+	entry, err := c.Cache.Load(key)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	var clientFactory restapi.HttpClientFactory = func() *http.Client {
+		return &http.Client{}
+	}
+	if c.Skipcert {
+		// Ignore certificate error for on-prem deployment
+		tr := &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+		}
+		clientFactory = func() *http.Client {
+			return &http.Client{Transport: tr}
+		}
+	}
+	restClient, err := restapi.GetNewRestClient(c.URL, clientFactory)
+	if err != nil {
+		return nil, err
+	}
+
+	token := &restapi.Token{
+		AccessToken: entry.AccessToken,
+		TokenType:   entry.TokenType,
+		Expiry:      entry.Expiry,
+	}
+	restClient.Headers["Authorization"] = token.AuthorizationHeader()
+	restClient.TokenSource = &cachedTokenSource{cache: c.Cache, key: key, token: token, login: func() (*restapi.RestClient, error) {
+		client, err := c.login()
+		if err == nil {
+			c.storeToken(client)
+		}
+		return client, err
+	}}
+	log.Debugf("Using cached token which expires at %v", entry.Expiry)
+	return restClient, nil
+}
+
+// storeToken saves token of authenticated client in cache. Failure to save is logged but otherwise ignored.
+func (c *VaultClient) storeToken(client *restapi.RestClient) {
+	var token *restapi.Token
+	var err error
+	if client.TokenSource != nil {
+		token, err = client.TokenSource.Token(context.Background())
+	} else {
+		token, err = headerToken(client)
+	}
+	if err != nil {
+		log.Infof("Unable to cache token: %v", err)
+		return
+	}
+
+	entry := &tokencache.Entry{
+		AccessToken: token.AccessToken,
+		TokenType:   token.TokenType,
+		Expiry:      token.Expiry,
+	}
+	if err := c.Cache.Store(c.cacheKey(), entry); err != nil {
+		log.Infof("Unable to cache token: %v", err)
+	}
+}
+
+// headerToken returns token client sends in Authorization header
+func headerToken(client *restapi.RestClient) (*restapi.Token, error) {
+	parts := strings.SplitN(client.Headers["Authorization"], " ", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("client has no bearer token")
+	}
+	return &restapi.Token{TokenType: parts[0], AccessToken: parts[1]}, nil
+}
+
+// cachedTokenSource supplies cached token and discards cache entry once the token is rejected by server.
+// It then authenticates again with login and supplies tokens of the new client from then on
+type cachedTokenSource struct {
+	mu       sync.Mutex
+	cache    *tokencache.Cache
+	key      tokencache.Key
+	token    *restapi.Token
+	rejected bool
+	login    func() (*restapi.RestClient, error)
+	source   restapi.TokenSource // Token source of the client returned by login, if it has one
+}
+
+func (ts *cachedTokenSource) Token(ctx context.Context) (*restapi.Token, error) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.source != nil {
+		return ts.source.Token(ctx)
+	}
+	if ts.rejected {
+		client, err := ts.login()
+		if err != nil {
+			return nil, fmt.Errorf("Cached token was rejected by tenant and authentication failed: %w", err)
+		}
+		if client.TokenSource != nil {
+			ts.source = client.TokenSource
+			return ts.source.Token(ctx)
+		}
+		token, err := headerToken(client)
+		if err != nil {
+			return nil, err
+		}
+		ts.token = token
+		ts.rejected = false
+	}
+	return ts.token, nil
+}
+
+func (ts *cachedTokenSource) Invalidate(rejected *restapi.Token) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if ts.source != nil {
+		ts.source.Invalidate(rejected)
+		return
+	}
+	ts.rejected = true
+	if err := ts.cache.Delete(ts.key); err != nil {
+		log.Infof("Unable to remove cached token: %v", err)
+	}
+}
@@ -0,0 +1,65 @@
+package utils
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi/restapitest"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/tokencache"
+)
+
+func TestCachedTokenRejected(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token-cache-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cache, err := tokencache.New(dir, "passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := tokencache.Key{URL: "https://tenant.example.com", AuthType: "oauth", User: "client"}
+	if err := cache.Store(key, &tokencache.Entry{AccessToken: "cached", TokenType: "Bearer"}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Tenant only accepts token of a new login
+	server := restapitest.NewServer(t, map[string]restapitest.Handler{
+		"/Security/WhoAmI": func(r *restapitest.Request) interface{} {
+			if r.Header.Get("Authorization") != "Bearer fresh" {
+				return restapitest.Status(http.StatusUnauthorized)
+			}
+			return map[string]interface{}{"User": "client"}
+		},
+	})
+	defer server.Close()
+	client := restapitest.NewClient(server)
+	var logins int
+	client.TokenSource = &cachedTokenSource{
+		cache: cache,
+		key:   key,
+		token: &restapi.Token{AccessToken: "cached", TokenType: "Bearer"},
+		login: func() (*restapi.RestClient, error) {
+			logins++
+			fresh := restapitest.NewClient(server)
+			fresh.Headers["Authorization"] = "Bearer fresh"
+			return fresh, nil
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := client.CallGenericMapAPI("/Security/WhoAmI", nil)
+		if err != nil || !resp.Success {
+			t.Fatalf("call %d: expected call to succeed after authenticating again, got %v", i+1, err)
+		}
+	}
+	if logins != 1 {
+		t.Errorf("expected one login, got %d", logins)
+	}
+	if entry, _ := cache.Load(key); entry != nil {
+		t.Errorf("expected rejected token to be removed from cache, got %+v", entry)
+	}
+}
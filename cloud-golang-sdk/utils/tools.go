@@ -8,23 +8,26 @@ import (
 	"syscall"
 
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/enum/authenticationtype"
+	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/tokencache"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
 // GetCmdParms parse command line argument
 func (c *VaultClient) GetCmdParms() {
 	// Common arguments
-	authTypePtr := flag.String("auth", "oauth", "Authentication type <oauth|unpw|dmc>")
+	authTypePtr := flag.String("auth", "oauth", "Authentication type <oauth|oauthpkce|oauthdevice|unpw|dmc>")
 	urlPtr := flag.String("url", "", "Centrify tenant URL (Required)")
 	skipCertPtr := flag.Bool("skipcert", false, "Ignore certification verification")
 	debugPtr := flag.Bool("debug", false, "Trun on debug logging")
+	cachePtr := flag.Bool("cache", false, "Cache token in encrypted file so that subsequent runs don't need to authenticate. Passphrase is read from "+tokencache.PassphraseEnv)
 
 	// Other arguments
-	appIDPtr := flag.String("appid", "", "OAuth2 application ID. Required if auth = oauth")
-	scopePtr := flag.String("scope", "", "OAuth2 or DMC scope definition. Required if auth = oauth or dmc")
+	appIDPtr := flag.String("appid", "", "OAuth2 application ID. Required if auth = oauth, oauthpkce or oauthdevice")
+	scopePtr := flag.String("scope", "", "OAuth2 or DMC scope definition. Required if auth = oauth, oauthpkce, oauthdevice or dmc")
 	tokenPtr := flag.String("token", "", "OAuth2 or DMC token. Optional if auth = oauth or dmc")
-	usernamePtr := flag.String("user", "", "Authorized user to login to tenant. Required if auth = unpw. Optional if auth = oauth")
+	usernamePtr := flag.String("user", "", "Authorized user to login to tenant. Required if auth = unpw. Optional if auth = oauth. OAuth2 client ID if auth = oauthpkce or oauthdevice, defaults to appid")
 	passwordPtr := flag.String("password", "", "User password. You will be prompted to enter password if this isn't provided")
+	portPtr := flag.Int("port", 0, "Loopback port receiving OAuth2 redirect if auth = oauthpkce. Any free port is used if this isn't provided")
 
 	prgname := os.Args[0]
 	flag.Usage = func() {
@@ -41,7 +44,7 @@ func (c *VaultClient) GetCmdParms() {
 	}
 
 	// Verify authTypePtr value
-	authChoices := map[string]bool{"oauth": true, "unpw": true, "dmc": true}
+	authChoices := map[string]bool{"oauth": true, "oauthpkce": true, "oauthdevice": true, "unpw": true, "dmc": true}
 	if _, validChoice := authChoices[*authTypePtr]; !validChoice {
 		flag.Usage()
 		os.Exit(1)
@@ -52,6 +55,20 @@ func (c *VaultClient) GetCmdParms() {
 		os.Exit(1)
 	}
 
+	// Password isn't needed if there is a cached token
+	var cache *tokencache.Cache
+	cached := false
+	if *cachePtr {
+		var err error
+		cache, err = tokencache.New("", "")
+		if err != nil {
+			fmt.Printf("Token cache is disabled: %v\n", err)
+		} else {
+			entry, _ := cache.Load(tokencache.Key{URL: *urlPtr, AuthType: *authTypePtr, User: *usernamePtr, Scope: *scopePtr})
+			cached = entry != nil
+		}
+	}
+
 	switch strings.ToLower(*authTypePtr) {
 	case authenticationtype.OAuth2.String():
 		if *appIDPtr == "" || *scopePtr == "" {
@@ -64,20 +81,26 @@ func (c *VaultClient) GetCmdParms() {
 			os.Exit(1)
 		}
 		// If password isn't provided, prompt for it
-		if *passwordPtr == "" {
+		if *passwordPtr == "" && !cached {
 			fmt.Print("Enter Password: ")
 			bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
 			password := strings.TrimSpace(string(bytePassword))
 			*passwordPtr = password
 			fmt.Println()
 		}
+	case authenticationtype.OAuth2PKCE.String(), authenticationtype.OAuth2DeviceCode.String():
+		// User logs in with browser so password isn't needed
+		if *appIDPtr == "" || *scopePtr == "" {
+			flag.Usage()
+			os.Exit(1)
+		}
 	case authenticationtype.UsernamePassword.String():
 		if *urlPtr == "" || *usernamePtr == "" {
 			flag.Usage()
 			os.Exit(1)
 		}
 		// If password isn't provided, prompt for it
-		if *passwordPtr == "" {
+		if *passwordPtr == "" && !cached {
 			fmt.Print("Enter Password: ")
 			bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
 			password := strings.TrimSpace(string(bytePassword))
@@ -100,5 +123,7 @@ func (c *VaultClient) GetCmdParms() {
 	c.User = *usernamePtr
 	c.Password = *passwordPtr
 	c.Skipcert = *skipCertPtr
+	c.Port = *portPtr
 	c.Debug = *debugPtr
+	c.Cache = cache
 }
@@ -0,0 +1,223 @@
+package webcookie
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// Environment variables read by ResponderFromEnv
+const (
+	EnvAnswerPrefix  = "CENTRIFY_MFA_ANSWER_" // followed by mechanism name, e.g. CENTRIFY_MFA_ANSWER_SQ
+	EnvTOTPSecret    = "CENTRIFY_MFA_TOTP_SECRET"
+	EnvMechanismPref = "CENTRIFY_MFA_MECHANISMS" // comma separated mechanism names in order of preference
+)
+
+// ChallengeResponder supplies answers to authentication challenges
+type ChallengeResponder interface {
+	// CanAnswer reports whether the responder is able to answer the mechanism
+	CanAnswer(mech AuthMechanism) bool
+	// Answer returns password, security question answer or verification code for the mechanism.
+	// Empty answer for out of band mechanism means it is approved out of band so authentication is polled.
+	Answer(mech AuthMechanism) (string, error)
+}
+
+// MechanismSelector is implemented by responders that choose mechanism themselves, e.g. by asking user
+type MechanismSelector interface {
+	SelectMechanism(mechanisms []AuthMechanism) (AuthMechanism, error)
+}
+
+// ChainResponder answers with the first responder that can answer the mechanism
+type ChainResponder []ChallengeResponder
+
+// CanAnswer reports whether any of the responders can answer the mechanism
+func (r ChainResponder) CanAnswer(mech AuthMechanism) bool {
+	for _, responder := range r {
+		if responder.CanAnswer(mech) {
+			return true
+		}
+	}
+	return false
+}
+
+// Answer returns answer of the first responder that can answer the mechanism
+func (r ChainResponder) Answer(mech AuthMechanism) (string, error) {
+	for _, responder := range r {
+		if responder.CanAnswer(mech) {
+			return responder.Answer(mech)
+		}
+	}
+	return "", fmt.Errorf("No answer for authentication mechanism %s", mech.Name)
+}
+
+// SelectMechanism delegates to the first responder that is a MechanismSelector.
+// If there is none, the first mechanism that can be answered is selected.
+func (r ChainResponder) SelectMechanism(mechanisms []AuthMechanism) (AuthMechanism, error) {
+	for _, responder := range r {
+		if selector, ok := responder.(MechanismSelector); ok {
+			return selector.SelectMechanism(mechanisms)
+		}
+	}
+	return firstAnswerable(r, mechanisms)
+}
+
+// AnswerResponder answers mechanisms with preset answers keyed by mechanism name (UP, SQ, SMS, OATH etc.).
+// Answer of a security question can also be keyed by the question itself.
+type AnswerResponder map[string]string
+
+// CanAnswer reports whether there is an answer for the mechanism
+func (r AnswerResponder) CanAnswer(mech AuthMechanism) bool {
+	_, ok := r.lookup(mech)
+	return ok
+}
+
+// Answer returns preset answer for the mechanism
+func (r AnswerResponder) Answer(mech AuthMechanism) (string, error) {
+	if answer, ok := r.lookup(mech); ok {
+		return answer, nil
+	}
+	return "", fmt.Errorf("No answer for authentication mechanism %s", mech.Name)
+}
+
+func (r AnswerResponder) lookup(mech AuthMechanism) (string, bool) {
+	if mech.Question != "" {
+		if answer, ok := r[mech.Question]; ok && answer != "" {
+			return answer, true
+		}
+	}
+	answer, ok := r[mech.Name]
+	return answer, ok && answer != ""
+}
+
+// OutOfBandResponder approves PF (phone call) and EMAIL (link) mechanisms out of band. Authentication
+// is polled until user answers the call or clicks the link.
+type OutOfBandResponder struct{}
+
+// CanAnswer reports whether the mechanism can be approved out of band
+func (OutOfBandResponder) CanAnswer(mech AuthMechanism) bool {
+	return mech.Name == "PF" || mech.Name == "EMAIL"
+}
+
+// Answer returns empty answer so that authentication is polled
+func (OutOfBandResponder) Answer(mech AuthMechanism) (string, error) {
+	fmt.Fprintf(os.Stderr, "Waiting for out of band approval: %s\n", mech.PromptMechChosen)
+	return "", nil
+}
+
+// TTYResponder asks user on terminal
+type TTYResponder struct{}
+
+// CanAnswer always returns true since user can answer any mechanism
+func (TTYResponder) CanAnswer(mech AuthMechanism) bool {
+	return true
+}
+
+// SelectMechanism lists the mechanisms and asks user to choose one
+func (TTYResponder) SelectMechanism(mechanisms []AuthMechanism) (AuthMechanism, error) {
+	fmt.Print("\n\n")
+	// Display mechanisms
+	for j, mechanism := range mechanisms {
+		displayNum := j + 1
+		fmt.Printf("%d. %s\n", displayNum, mechanism.PromptSelectMech)
+	}
+	reader := bufio.NewReader(os.Stdin)
+	fmt.Print("Please choose an authentication mechanism: ")
+	input, _ := reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	choice, err := strconv.Atoi(input)
+	choice = choice - 1
+	if choice < 0 || choice > len(mechanisms)-1 || err != nil {
+		return AuthMechanism{}, fmt.Errorf("Invalid choice")
+	}
+	return mechanisms[choice], nil
+}
+
+// Answer prompts user for password, security question answer or verification code
+func (TTYResponder) Answer(mech AuthMechanism) (string, error) {
+	switch {
+	case mech.Name == "UP":
+		fmt.Print("Enter Password: ")
+	case mech.Question != "":
+		// Security question prompt
+		fmt.Printf("%s : ", mech.Question)
+	default:
+		fmt.Print("Hit Enter if you have already authenticated out-of-bound or Enter Verification Code: ")
+	}
+	bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf("Unable to read answer from terminal: %v", err)
+	}
+	return strings.TrimSpace(string(bytePassword)), nil
+}
+
+// ResponderFromEnv returns responder that answers with CENTRIFY_MFA_ANSWER_<mechanism> variables,
+// generates OATH codes from CENTRIFY_MFA_TOTP_SECRET and approves PF and EMAIL out of band.
+// password, if not empty, answers UP mechanism.
+func ResponderFromEnv(password string) ChainResponder {
+	answers := AnswerResponder{}
+	for _, env := range os.Environ() {
+		kv := strings.SplitN(env, "=", 2)
+		if len(kv) == 2 && strings.HasPrefix(kv[0], EnvAnswerPrefix) {
+			answers[strings.TrimPrefix(kv[0], EnvAnswerPrefix)] = kv[1]
+		}
+	}
+	if password != "" {
+		answers["UP"] = password
+	}
+
+	responder := ChainResponder{answers}
+	if secret := os.Getenv(EnvTOTPSecret); secret != "" {
+		responder = append(responder, &TOTPResponder{Secret: secret})
+	}
+	return append(responder, OutOfBandResponder{})
+}
+
+// MechanismPreferenceFromEnv returns mechanism names listed in CENTRIFY_MFA_MECHANISMS
+func MechanismPreferenceFromEnv() []string {
+	var names []string
+	for _, name := range strings.Split(os.Getenv(EnvMechanismPref), ",") {
+		if name = strings.ToUpper(strings.TrimSpace(name)); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// selectMechanism chooses mechanism of a challenge. Mechanisms are tried in order of preference first,
+// then the responder is asked if it is a MechanismSelector, otherwise first mechanism it can answer is used.
+func selectMechanism(responder ChallengeResponder, preference []string, mechanisms []AuthMechanism) (AuthMechanism, error) {
+	if len(mechanisms) == 0 {
+		return AuthMechanism{}, fmt.Errorf("Challenge has no authentication mechanism")
+	}
+	if len(mechanisms) == 1 {
+		return mechanisms[0], nil
+	}
+	for _, name := range preference {
+		for _, mech := range mechanisms {
+			if strings.EqualFold(mech.Name, name) && responder.CanAnswer(mech) {
+				return mech, nil
+			}
+		}
+	}
+	if selector, ok := responder.(MechanismSelector); ok {
+		return selector.SelectMechanism(mechanisms)
+	}
+	return firstAnswerable(responder, mechanisms)
+}
+
+func firstAnswerable(responder ChallengeResponder, mechanisms []AuthMechanism) (AuthMechanism, error) {
+	var names []string
+	for _, mech := range mechanisms {
+		if responder.CanAnswer(mech) {
+			return mech, nil
+		}
+		names = append(names, mech.Name)
+	}
+	return AuthMechanism{}, fmt.Errorf("None of authentication mechanisms %v can be answered", names)
+}
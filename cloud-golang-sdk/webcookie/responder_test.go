@@ -0,0 +1,130 @@
+package webcookie
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestTOTPGenerate(t *testing.T) {
+	// Test vectors of RFC 6238 for SHA1, secret "12345678901234567890"
+	r := &TOTPResponder{Secret: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", Digits: 8}
+	cases := []struct {
+		unix int64
+		code string
+	}{
+		{59, "94287082"},
+		{1111111109, "07081804"},
+		{1234567890, "89005924"},
+		{20000000000, "65353130"},
+	}
+	for _, tc := range cases {
+		code, err := r.Generate(time.Unix(tc.unix, 0))
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if code != tc.code {
+			t.Errorf("time %d: expected %s, got %s", tc.unix, tc.code, code)
+		}
+	}
+}
+
+func TestTOTPInvalidSettings(t *testing.T) {
+	cases := []*TOTPResponder{
+		{Digits: 5},
+		{Digits: 10},
+		{Period: 500 * time.Millisecond},
+		{Period: -time.Second},
+	}
+	for _, r := range cases {
+		r.Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+		if _, err := r.Generate(time.Unix(59, 0)); err == nil {
+			t.Errorf("expected digits %d and period %v to be rejected", r.Digits, r.Period)
+		}
+	}
+}
+
+func TestSelectMechanism(t *testing.T) {
+	mechanisms := []AuthMechanism{{Name: "SMS"}, {Name: "OATH"}, {Name: "PF"}}
+	responder := ChainResponder{&TOTPResponder{Secret: "GEZDGNBV"}, OutOfBandResponder{}}
+
+	cases := []struct {
+		preference []string
+		expected   string
+	}{
+		{[]string{"PF", "OATH"}, "PF"},
+		{[]string{"sms", "OATH"}, "OATH"}, // SMS can't be answered
+		{nil, "OATH"},
+	}
+	for _, tc := range cases {
+		mech, err := selectMechanism(responder, tc.preference, mechanisms)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		if mech.Name != tc.expected {
+			t.Errorf("preference %v: expected %s, got %s", tc.preference, tc.expected, mech.Name)
+		}
+	}
+
+	if _, err := selectMechanism(AnswerResponder{"UP": "pass"}, nil, mechanisms); err == nil {
+		t.Fatalf("expected error when no mechanism can be answered")
+	}
+}
+
+func TestNonInteractiveAuthentication(t *testing.T) {
+	polls := 0
+	answers := map[string]string{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&args)
+		switch r.URL.Path {
+		case "/Security/StartAuthentication":
+			w.Write([]byte(`{"success":true,"Result":{"SessionId":"session","TenantId":"tenant","Challenges":[
+				{"Mechanisms":[{"Name":"UP","MechanismId":"up"}]},
+				{"Mechanisms":[{"Name":"EMAIL","MechanismId":"email"},{"Name":"PF","MechanismId":"pf"}]}]}}`))
+		case "/Security/AdvanceAuthentication":
+			switch {
+			case args["MechanismId"] == "up" && args["Action"] == "Answer":
+				answers["up"] = args["Answer"]
+				w.Write([]byte(`{"success":true,"Result":{"Summary":"StartNextChallenge"}}`))
+			case args["MechanismId"] == "pf" && args["Action"] == "StartOOB":
+				w.Write([]byte(`{"success":true,"Result":{"Summary":"OobPending"}}`))
+			case args["MechanismId"] == "pf" && args["Action"] == "Poll":
+				if polls++; polls < 3 {
+					w.Write([]byte(`{"success":true,"Result":{"Summary":"OobPending"}}`))
+					return
+				}
+				http.SetCookie(w, &http.Cookie{Name: ".ASPXAUTH", Value: "authtoken"})
+				w.Write([]byte(`{"success":true,"Result":{"Summary":"LoginSuccess"}}`))
+			default:
+				w.Write([]byte(`{"success":false,"Message":"unexpected request"}`))
+			}
+		}
+	}))
+	defer server.Close()
+
+	jar, _ := cookiejar.New(nil)
+	c := &WebCookie{ClientID: "admin@tenant", ClientSecret: "password"}
+	c.Service = server.URL
+	c.Client = server.Client()
+	c.Client.Jar = jar
+	c.Headers = map[string]string{}
+	c.Responder = ChainResponder{AnswerResponder{"UP": c.ClientSecret}, OutOfBandResponder{}}
+	c.MechanismPreference = []string{"PF"}
+	c.PollInterval = time.Millisecond
+
+	authResp, err := c.startAuthentication()
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	token, err := c.advanceAuthentication(authResp)
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if token != "authtoken" || answers["up"] != "password" || polls != 3 {
+		t.Fatalf("unexpected result: token %q, password %q, %d polls", token, answers["up"], polls)
+	}
+}
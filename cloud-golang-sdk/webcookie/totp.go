@@ -0,0 +1,72 @@
+package webcookie
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TOTPResponder answers OATH mechanism with time based one time password (RFC 6238) generated from
+// the secret the authenticator app was seeded with
+type TOTPResponder struct {
+	Secret string        // Base32 encoded secret, as shown when OATH token is registered
+	Digits int           // Number of digits, 6 to 8. Defaults to 6
+	Period time.Duration // Time step, at least 1 second. Defaults to 30 seconds
+	Now    func() time.Time
+}
+
+// CanAnswer reports whether the mechanism is OATH
+func (r *TOTPResponder) CanAnswer(mech AuthMechanism) bool {
+	return mech.Name == "OATH"
+}
+
+// Answer returns the current one time password
+func (r *TOTPResponder) Answer(mech AuthMechanism) (string, error) {
+	now := time.Now
+	if r.Now != nil {
+		now = r.Now
+	}
+	return r.Generate(now())
+}
+
+// Generate returns one time password for time t
+func (r *TOTPResponder) Generate(t time.Time) (string, error) {
+	secret := strings.ToUpper(strings.Replace(r.Secret, " ", "", -1))
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return "", fmt.Errorf("Invalid TOTP secret: %v", err)
+	}
+	digits := r.Digits
+	if digits == 0 {
+		digits = 6
+	}
+	if digits < 6 || digits > 8 {
+		return "", fmt.Errorf("Invalid TOTP digits %d, must be between 6 and 8", r.Digits)
+	}
+	period := r.Period
+	if period == 0 {
+		period = 30 * time.Second
+	}
+	if period < time.Second {
+		return "", fmt.Errorf("Invalid TOTP period %v, must be at least 1 second", r.Period)
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(t.Unix()/int64(period/time.Second)))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, code%mod), nil
+}
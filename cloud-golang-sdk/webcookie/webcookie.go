@@ -1,20 +1,16 @@
 package webcookie
 
 import (
-	"bufio"
 	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"os"
-	"strconv"
 	"strings"
-	"syscall"
+	"time"
 
 	log "github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/logging"
 	"github.com/centrify/terraform-provider-centrify/cloud-golang-sdk/restapi"
-	"golang.org/x/crypto/ssh/terminal"
 )
 
 // WebCookie represents a stateful web cookie client
@@ -25,8 +21,18 @@ type WebCookie struct {
 	SkipCertVerify bool
 	SessionID      string
 	TenantID       string
+
+	Responder           ChallengeResponder // Answers authentication challenges. Prompts on terminal if it is nil
+	MechanismPreference []string           // Mechanism names tried first when a challenge offers several, e.g. OATH, PF
+	PollInterval        time.Duration      // Interval of polling out of band mechanisms. Defaults to 3 seconds
+	PollTimeout         time.Duration      // How long to wait for out of band approval. Defaults to 2 minutes
 }
 
+const (
+	defaultPollInterval = 3 * time.Second
+	defaultPollTimeout  = 2 * time.Minute
+)
+
 func (c *WebCookie) startAuthentication() (*AuthResponse, error) {
 	method := "/Security/StartAuthentication"
 	args := make(map[string]interface{})
@@ -60,45 +66,25 @@ func (c *WebCookie) startAuthentication() (*AuthResponse, error) {
 func (c *WebCookie) advanceAuthentication(authResp *AuthResponse) (string, error) {
 	var token string
 	challenges := authResp.Result.Challenges
+	responder := c.responder()
 
-	//var authMechs []AuthMechanism
 	for i, challenge := range challenges {
 		log.Debugf("Challenge number: %d\n", i+1)
-		mechanisms := challenge.Mechanisms
-		var authMech AuthMechanism
-		if len(mechanisms) > 1 {
-			fmt.Print("\n\n")
-			// Display mechanisms
-			for j, mechanism := range mechanisms {
-				displayNum := j + 1
-				fmt.Printf("%d. %s\n", displayNum, mechanism.PromptSelectMech)
-			}
-			reader := bufio.NewReader(os.Stdin)
-			fmt.Print("Please choose an authentication mechanism: ")
-			input, _ := reader.ReadString('\n')
-			input = strings.TrimSuffix(input, "\n")
-			choice, err := strconv.Atoi(input)
-			choice = choice - 1
-			if choice < 0 || choice > len(mechanisms)-1 || err != nil {
-				return "", fmt.Errorf("Invalid choice")
-			}
-			authMech = mechanisms[choice]
-			log.Debugf("selected mech: %+v\n", authMech)
-		} else {
-			// Only one mechanism so go ahead to ask for credential
-			authMech = mechanisms[0]
-
+		authMech, err := selectMechanism(responder, c.MechanismPreference, challenge.Mechanisms)
+		if err != nil {
+			return "", err
 		}
-		var err error
+		log.Debugf("selected mech: %+v\n", authMech)
+
 		// Enter credential
 		switch authMech.Name {
 		case "UP", "SQ":
-			token, err = c.doUPAuthentication(authMech)
+			token, err = c.doUPAuthentication(responder, authMech)
 			if err != nil {
 				return "", fmt.Errorf("Password authentication failed: %+v", err)
 			}
 		case "OATH", "SMS", "EMAIL", "PF":
-			token, err = c.doOOBAuthentication(authMech)
+			token, err = c.doOOBAuthentication(responder, authMech)
 			if err != nil {
 				return "", fmt.Errorf("Verificstion code authentication failed: %+v", err)
 			}
@@ -109,66 +95,75 @@ func (c *WebCookie) advanceAuthentication(authResp *AuthResponse) (string, error
 	return token, nil
 }
 
-func (c *WebCookie) postAuthRequest(args map[string]interface{}) (string, error) {
+// responder returns configured Responder. By default password answers UP mechanism
+// and anything else is prompted on terminal.
+func (c *WebCookie) responder() ChallengeResponder {
+	if c.Responder != nil {
+		return c.Responder
+	}
+	responder := ChainResponder{}
+	if c.ClientSecret != "" {
+		responder = append(responder, AnswerResponder{"UP": c.ClientSecret})
+	}
+	return append(responder, TTYResponder{})
+}
+
+// postAuthRequest advances authentication. It returns auth cookie once login succeeds and summary of the response.
+func (c *WebCookie) postAuthRequest(args map[string]interface{}) (string, string, error) {
 	method := "/Security/AdvanceAuthentication"
 	httpresp, err := c.postAndGetResp(method, args)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
+	defer httpresp.Body.Close()
 	if httpresp.StatusCode != 200 {
-		return "", fmt.Errorf("Bad http status code %v", httpresp.StatusCode)
+		return "", "", fmt.Errorf("Bad http status code %v", httpresp.StatusCode)
 	}
 
 	body, _ := ioutil.ReadAll(httpresp.Body)
 	resp, err := NewAdvanceAuthResponse(body)
 	if err != nil {
-		return "", fmt.Errorf("Error process respond body: %v", err)
+		return "", "", fmt.Errorf("Error process respond body: %v", err)
 	}
 	log.Debugf("AdvanceAuthentication response: %+v\n", resp)
 
 	if !resp.Success {
-		return "", fmt.Errorf("Authentication failed: %s", resp.Message)
+		return "", "", fmt.Errorf("Authentication failed: %s", resp.Message)
 	}
 
 	authResult := resp.Result["Summary"]
 	if authResult != nil {
-		switch authResult.(string) {
+		summary, _ := authResult.(string)
+		switch summary {
 		case "LoginSuccess":
 			// Get auth cookie
 			cookie := httpresp.Cookies()
 			//log.Debugf("Cookies: %+v\n", getCookieByName(cookie, ".ASPXAUTH"))
-			return getCookieByName(cookie, ".ASPXAUTH"), nil
-		case "StartNextChallenge":
-			return "", nil
-		case "OobPending":
-			return "", nil
+			return getCookieByName(cookie, ".ASPXAUTH"), summary, nil
+		case "StartNextChallenge", "OobPending":
+			return "", summary, nil
 		default:
-			return "", fmt.Errorf("%+v", resp.Result)
+			return "", summary, fmt.Errorf("%+v", resp.Result)
 		}
 	}
-	return "", nil
+	return "", "", nil
 }
 
-func (c *WebCookie) doUPAuthentication(authMech AuthMechanism) (string, error) {
+func (c *WebCookie) doUPAuthentication(responder ChallengeResponder, authMech AuthMechanism) (string, error) {
 	args := make(map[string]interface{})
 	args["TenantId"] = c.TenantID
 	args["SessionId"] = c.SessionID
 	args["MechanismId"] = authMech.MechanismID
 	args["Action"] = "Answer"
 
-	if authMech.Question != "" {
-		// Security question prompt
-		fmt.Printf("%s : ", authMech.Question)
-	} else {
-		// Password prompt
-		fmt.Print("Enter Password: ")
+	answer, err := responder.Answer(authMech)
+	if err != nil {
+		return "", err
 	}
-	bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
-	password := strings.TrimSpace(string(bytePassword))
-	args["Answer"] = password
+	args["Answer"] = answer
 
 	log.Debugf("Performing password authentication with action: %s\n", args["Action"])
-	cookie, err := c.postAuthRequest(args)
+	cookie, _, err := c.postAuthRequest(args)
 	if err != nil {
 		return "", err
 	}
@@ -176,7 +171,7 @@ func (c *WebCookie) doUPAuthentication(authMech AuthMechanism) (string, error) {
 	return cookie, nil
 }
 
-func (c *WebCookie) doOOBAuthentication(authMech AuthMechanism) (string, error) {
+func (c *WebCookie) doOOBAuthentication(responder ChallengeResponder, authMech AuthMechanism) (string, error) {
 	// For SMS, Phone, OTP and Email, first trigger the sending of verification code
 	args := make(map[string]interface{})
 	args["TenantId"] = c.TenantID
@@ -184,32 +179,50 @@ func (c *WebCookie) doOOBAuthentication(authMech AuthMechanism) (string, error)
 	args["MechanismId"] = authMech.MechanismID
 	args["Action"] = "StartOOB"
 
-	var cookie string
-	var err error
 	log.Debugf("Starting OOB authentication: %+v\n", args)
-	cookie, err = c.postAuthRequest(args)
+	cookie, _, err := c.postAuthRequest(args)
 	if err != nil {
 		return "", err
 	}
+	if cookie != "" {
+		return cookie, nil
+	}
 
-	// After triggering verification code, prompt to enter code
-	if cookie == "" {
-		fmt.Print("Hit Enter if you have already authenticated out-of-bound or Enter Verification Code: ")
-		bytePassword, _ := terminal.ReadPassword(int(syscall.Stdin))
-		password := strings.TrimSpace(string(bytePassword))
-		if password != "" {
-			args["Action"] = "Answer"
-			args["Answer"] = password
-		} else {
-			args["Action"] = "Poll"
-		}
+	// After triggering verification code, ask for the code
+	answer, err := responder.Answer(authMech)
+	if err != nil {
+		return "", err
+	}
+	if answer != "" {
+		args["Action"] = "Answer"
+		args["Answer"] = answer
 		log.Debugf("Performing OOB authentication with action: %s\n", args["Action"])
-		cookie, err = c.postAuthRequest(args)
-		if err != nil {
-			return "", err
+		cookie, _, err = c.postAuthRequest(args)
+		return cookie, err
+	}
+
+	// No code means mechanism is approved out of band, poll until it is done
+	args["Action"] = "Poll"
+	interval := c.PollInterval
+	if interval <= 0 {
+		interval = defaultPollInterval
+	}
+	timeout := c.PollTimeout
+	if timeout <= 0 {
+		timeout = defaultPollTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	for {
+		log.Debugf("Performing OOB authentication with action: %s\n", args["Action"])
+		cookie, summary, err := c.postAuthRequest(args)
+		if err != nil || summary != "OobPending" {
+			return cookie, err
+		}
+		if time.Now().Add(interval).After(deadline) {
+			return "", fmt.Errorf("%s authentication wasn't approved within %v", authMech.Name, timeout)
 		}
+		time.Sleep(interval)
 	}
-	return cookie, nil
 }
 
 func (c *WebCookie) postAndGetResp(method string, args map[string]interface{}) (*http.Response, error) {
@@ -0,0 +1,24 @@
+data "centrify_system" "centos1" {
+    name = "centos1"
+    fqdn = "centos1.demo.lab"
+    computer_class = "Unix"
+}
+
+data "centrify_account" "centos1_root" {
+    name = "root"
+    host_id = data.centrify_system.centos1.id
+}
+
+variable "release" {
+    type = string
+}
+
+// Rotates root password of centos1 whenever a new release is deployed and fails the apply if new password doesn't verify
+resource "centrify_account_rotation" "centos1_root" {
+    account_id = data.centrify_account.centos1_root.id
+    unlock = true
+
+    triggers = {
+        release = var.release
+    }
+}
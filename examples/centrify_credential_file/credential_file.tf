@@ -0,0 +1,27 @@
+data "centrify_system" "centos1" {
+    name = "centos1"
+    fqdn = "centos1.demo.lab"
+    computer_class = "Unix"
+}
+
+data "centrify_account" "centos1_root" {
+    name = "root"
+    host_id = data.centrify_system.centos1.id
+}
+
+// Password of root is checked out during apply and written to a file that is removed, and password checked in,
+// when apply completes. Password isn't stored in state
+resource "centrify_credential_file" "centos1_root" {
+    account_id = data.centrify_account.centos1_root.id
+    path = "${path.module}/.centos1_root"
+}
+
+resource "null_resource" "provision" {
+    triggers = {
+        coid = centrify_credential_file.centos1_root.coid
+    }
+
+    provisioner "local-exec" {
+        command = "sshpass -f ${centrify_credential_file.centos1_root.path} ssh root@centos1.demo.lab uptime"
+    }
+}
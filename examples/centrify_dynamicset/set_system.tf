@@ -0,0 +1,25 @@
+data "centrify_role" "system_admin" {
+    name = "System Administrator"
+}
+
+// Systems whose name starts with web, including ones added later
+resource "centrify_dynamicset" "web_systems" {
+    name = "Web Systems"
+    type = "Server"
+    description = "This Set contains all web systems."
+    query = "SELECT ID FROM Server WHERE Name LIKE 'web%'"
+
+    permission {
+        principal_id = data.centrify_role.system_admin.id
+        principal_name = data.centrify_role.system_admin.name
+        principal_type = "Role"
+        rights = ["Grant","View"]
+    }
+
+    member_permission {
+        principal_id = data.centrify_role.system_admin.id
+        principal_name = data.centrify_role.system_admin.name
+        principal_type = "Role"
+        rights = ["View","ManageSession"]
+    }
+}
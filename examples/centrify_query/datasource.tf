@@ -0,0 +1,27 @@
+# Unix systems in a set that have no proxy account
+data "centrify_query" "unix_without_proxy" {
+    script = <<-EOT
+        SELECT Server.ID, Server.Name, Server.FQDN FROM Server
+        WHERE Server.ComputerClass = @class AND Server.ProxyUser IS NULL
+        AND Server.ID IN (SELECT Key FROM CollectionMembers WHERE CollectionName = @set)
+    EOT
+    args = {
+        class = "Unix"
+        set = "Linux Servers"
+    }
+    max_rows = 500
+}
+
+output "total" {
+  value = data.centrify_query.unix_without_proxy.total
+}
+output "rows" {
+  value = data.centrify_query.unix_without_proxy.rows
+}
+
+# Drive for_each over live inventory
+data "centrify_account" "root" {
+    for_each = { for row in data.centrify_query.unix_without_proxy.rows : row.Name => row }
+    name = "root"
+    host_id = each.value.ID
+}
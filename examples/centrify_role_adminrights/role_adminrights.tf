@@ -0,0 +1,13 @@
+// Role whose membership is owned by application team
+data "centrify_role" "app_admins" {
+    name = "App Admins"
+}
+
+// Administrative rights owned by security team
+resource "centrify_role_adminrights" "app_admins" {
+    role_id = data.centrify_role.app_admins.id
+    rights = [
+        "Privileged Access Service User",
+        "Report Management",
+    ]
+}
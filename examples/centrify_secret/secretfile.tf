@@ -0,0 +1,15 @@
+resource "centrify_secret" "keystore" {
+    secret_name = "Keystore"
+    description = "Java keystore of web server"
+    type = "File"
+    file_path = "${path.module}/files/keystore.jks"
+    folder_id = centrify_secretfolder.level2_folder.id
+}
+
+resource "centrify_secret" "certificate" {
+    secret_name = "Web Server Certificate"
+    type = "File"
+    file_content_base64 = filebase64("${path.module}/files/server.pem")
+    file_name = "server.pem"
+    folder_id = centrify_secretfolder.level2_folder.id
+}
@@ -0,0 +1,16 @@
+// Existing set owned by another team
+data "centrify_manualset" "aws_systems" {
+    type = "Server"
+    name = "AWS Systems"
+}
+
+// Systems owned by this configuration
+data "centrify_systems" "web" {
+    name_pattern = "web*"
+}
+
+// Adds web systems to the set. Members added by others are left alone
+resource "centrify_set_membership" "web_systems" {
+    set_id = data.centrify_manualset.aws_systems.id
+    members = data.centrify_systems.web.ids
+}
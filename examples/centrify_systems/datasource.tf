@@ -0,0 +1,33 @@
+# All Unix web servers
+data "centrify_systems" "web" {
+    computer_class = "Unix"
+    name_pattern = "web-*"
+}
+
+# Systems whose name ends with a number
+data "centrify_systems" "numbered" {
+    name_regex = "[0-9]+$"
+}
+
+output "web_server_ids" {
+  value = data.centrify_systems.web.ids
+}
+
+output "web_server_fqdns" {
+  value = [for s in data.centrify_systems.web.items : s.fqdn]
+}
+
+# Grant a role permissions on every matched system's root account
+data "centrify_role" "web_admins" {
+    name = "Web Admins"
+}
+
+data "centrify_accounts" "web_root" {
+    for_each = { for s in data.centrify_systems.web.items : s.name => s }
+    name = "root"
+    host_id = each.value.id
+}
+
+output "web_root_account_ids" {
+  value = flatten([for a in data.centrify_accounts.web_root : a.ids])
+}
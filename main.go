@@ -1,6 +1,8 @@
 package main
 
 import (
+	"log"
+
 	"github.com/centrify/terraform-provider-centrify/centrify"
 
 	"github.com/hashicorp/terraform-plugin-sdk/plugin"
@@ -13,4 +15,9 @@ func main() {
 			return centrify.Provider()
 		},
 	})
+
+	// Remove credential files and check in passwords left checked out once Terraform is done with the provider
+	if err := centrify.Cleanup(); err != nil {
+		log.Printf("[ERROR] %v", err)
+	}
 }